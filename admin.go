@@ -0,0 +1,65 @@
+package sdk
+
+import "time"
+
+// AdminSettings are the settings of the Grafana server by sections of
+// the configuration file. Secrets are masked by Grafana.
+type AdminSettings map[string]map[string]string
+
+// Get returns the value of the setting or empty string if it is not set.
+func (s AdminSettings) Get(section, key string) string {
+	return s[section][key]
+}
+
+// AdminStats are the usage statistics of the Grafana server.
+type AdminStats struct {
+	Orgs                int64 `json:"orgs"`
+	Dashboards          int64 `json:"dashboards"`
+	Snapshots           int64 `json:"snapshots"`
+	Tags                int64 `json:"tags"`
+	Datasources         int64 `json:"datasources"`
+	Playlists           int64 `json:"playlists"`
+	Stars               int64 `json:"stars"`
+	Alerts              int64 `json:"alerts"`
+	Users               int64 `json:"users"`
+	Admins              int64 `json:"admins"`
+	Editors             int64 `json:"editors"`
+	Viewers             int64 `json:"viewers"`
+	ActiveUsers         int64 `json:"activeUsers"`
+	ActiveAdmins        int64 `json:"activeAdmins"`
+	ActiveEditors       int64 `json:"activeEditors"`
+	ActiveViewers       int64 `json:"activeViewers"`
+	ActiveSessions      int64 `json:"activeSessions"`
+	DailyActiveUsers    int64 `json:"dailyActiveUsers"`
+	DailyActiveAdmins   int64 `json:"dailyActiveAdmins"`
+	DailyActiveEditors  int64 `json:"dailyActiveEditors"`
+	DailyActiveViewers  int64 `json:"dailyActiveViewers"`
+	DailyActiveSessions int64 `json:"dailyActiveSessions"`
+	MonthlyActiveUsers  int64 `json:"monthlyActiveUsers"`
+}
+
+// UserAuthToken is the login session of the user.
+type UserAuthToken struct {
+	ID             uint      `json:"id"`
+	IsActive       bool      `json:"isActive"`
+	ClientIP       string    `json:"clientIp"`
+	Browser        string    `json:"browser"`
+	BrowserVersion string    `json:"browserVersion"`
+	OS             string    `json:"os"`
+	OSVersion      string    `json:"osVersion"`
+	Device         string    `json:"device"`
+	CreatedAt      time.Time `json:"createdAt"`
+	SeenAt         time.Time `json:"seenAt"`
+}
+
+// ProvisionedType is the kind of provisioned objects which configuration
+// could be reloaded, see Client.ReloadProvisioning.
+type ProvisionedType string
+
+// Kinds of provisioned objects.
+const (
+	ProvisionedDashboards    ProvisionedType = "dashboards"
+	ProvisionedDatasources   ProvisionedType = "datasources"
+	ProvisionedNotifications ProvisionedType = "notifications"
+	ProvisionedPlugins       ProvisionedType = "plugins"
+)
@@ -0,0 +1,137 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrMissingInputs is returned when some of the inputs declared by
+// the dashboard in __inputs have no value.
+var ErrMissingInputs = errors.New("missing dashboard inputs")
+
+// Types of dashboard inputs.
+const (
+	InputTypeDatasource = "datasource"
+	InputTypeConstant   = "constant"
+)
+
+// DashboardInput is the input declared in __inputs of a dashboard
+// exported for sharing externally. References to the input look like
+// ${DS_PROMETHEUS} in the dashboard JSON.
+type DashboardInput struct {
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	PluginID    string `json:"pluginId,omitempty"`
+	PluginName  string `json:"pluginName,omitempty"`
+	// Value is the default value of constant inputs.
+	Value string `json:"value,omitempty"`
+}
+
+// ImportDashboardInput is the value of the dashboard input. For
+// datasource inputs the value is the datasource uid or name.
+type ImportDashboardInput struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	PluginID string `json:"pluginId,omitempty"`
+	Value    string `json:"value"`
+}
+
+// GetDashboardInputs returns the inputs declared in __inputs of the raw
+// dashboard JSON.
+func GetDashboardInputs(raw []byte) ([]DashboardInput, error) {
+	var board struct {
+		Inputs []DashboardInput `json:"__inputs"`
+	}
+	if err := json.Unmarshal(raw, &board); err != nil {
+		return nil, err
+	}
+	return board.Inputs, nil
+}
+
+// NewImportInputs returns the inputs for the raw dashboard JSON with
+// the values taken by names of the inputs. Constant inputs without
+// values get their default values. The error wraps ErrMissingInputs
+// if some of the inputs have no value.
+func NewImportInputs(raw []byte, values map[string]string) ([]ImportDashboardInput, error) {
+	declared, err := GetDashboardInputs(raw)
+	if err != nil {
+		return nil, err
+	}
+	inputs := make([]ImportDashboardInput, 0, len(declared))
+	for _, d := range declared {
+		value, ok := values[d.Name]
+		if !ok && d.Type == InputTypeConstant {
+			value = d.Value
+		}
+		inputs = append(inputs, ImportDashboardInput{Name: d.Name, Type: d.Type, PluginID: d.PluginID, Value: value})
+	}
+	return inputs, ValidateDashboardInputs(raw, inputs)
+}
+
+// ValidateDashboardInputs checks that every input declared in __inputs of
+// the raw dashboard JSON has non empty value. The error wraps
+// ErrMissingInputs and lists the inputs without values.
+func ValidateDashboardInputs(raw []byte, inputs []ImportDashboardInput) error {
+	declared, err := GetDashboardInputs(raw)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]string, len(inputs))
+	for _, in := range inputs {
+		values[in.Name] = in.Value
+	}
+	var missing []string
+	for _, d := range declared {
+		if values[d.Name] == "" {
+			missing = append(missing, d.Name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%w: %s", ErrMissingInputs, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// ResolveDashboardInputs substitutes ${NAME} references to the inputs in
+// string values of the raw dashboard JSON offline, as Grafana does on
+// import. The __inputs and __requires blocks and the dashboard id are
+// removed so the result could be saved with SetRawDashboard.
+func ResolveDashboardInputs(raw []byte, inputs []ImportDashboardInput) ([]byte, error) {
+	if err := ValidateDashboardInputs(raw, inputs); err != nil {
+		return nil, err
+	}
+	var board map[string]interface{}
+	if err := decodeJSON(raw, &board); err != nil {
+		return nil, err
+	}
+	delete(board, "__inputs")
+	delete(board, "__requires")
+	delete(board, "id")
+	pairs := make([]string, 0, len(inputs)*2)
+	for _, in := range inputs {
+		pairs = append(pairs, "${"+in.Name+"}", in.Value)
+	}
+	return json.Marshal(replaceInputs(board, strings.NewReplacer(pairs...)))
+}
+
+func replaceInputs(v interface{}, r *strings.Replacer) interface{} {
+	switch value := v.(type) {
+	case string:
+		return r.Replace(value)
+	case map[string]interface{}:
+		for k, field := range value {
+			value[k] = replaceInputs(field, r)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = replaceInputs(item, r)
+		}
+	}
+	return v
+}
@@ -0,0 +1,131 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// DashboardVersion is metadata of a saved version of the dashboard.
+type DashboardVersion struct {
+	ID            uint      `json:"id"`
+	DashboardID   uint      `json:"dashboardId"`
+	DashboardUID  string    `json:"uid,omitempty"`
+	ParentVersion int       `json:"parentVersion"`
+	RestoredFrom  int       `json:"restoredFrom"`
+	Version       int       `json:"version"`
+	Created       time.Time `json:"created"`
+	CreatedBy     string    `json:"createdBy"`
+	Message       string    `json:"message"`
+}
+
+// DashboardVersionsPage is a page of the dashboard versions. Grafana 11
+// returns the token to get the next page with, it is empty for the last
+// page and for the older versions of Grafana.
+type DashboardVersionsPage struct {
+	Versions      []DashboardVersion `json:"versions"`
+	ContinueToken string             `json:"continueToken,omitempty"`
+}
+
+// DashboardChange is a single difference between two dashboard
+// versions. Path addresses the changed value in the dashboard JSON,
+// for example "panels[2].title". Old is nil for added values and New
+// is nil for removed ones.
+type DashboardChange struct {
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+// String returns the change in human readable form.
+func (c DashboardChange) String() string {
+	switch {
+	case c.Old == nil:
+		return fmt.Sprintf("+ %s: %s", c.Path, diffValue(c.New))
+	case c.New == nil:
+		return fmt.Sprintf("- %s: %s", c.Path, diffValue(c.Old))
+	}
+	return fmt.Sprintf("~ %s: %s -> %s", c.Path, diffValue(c.Old), diffValue(c.New))
+}
+
+// DiffDashboards compares two dashboard JSONs and returns the changes
+// from base to changed ordered by path. Objects are compared by keys
+// and arrays by positions. Values of changes are decoded JSON values:
+// map[string]interface{}, []interface{}, string, json.Number, bool.
+func DiffDashboards(base, changed []byte) ([]DashboardChange, error) {
+	var a, b interface{}
+	if err := decodeJSON(base, &a); err != nil {
+		return nil, fmt.Errorf("unmarshal base dashboard: %s", err)
+	}
+	if err := decodeJSON(changed, &b); err != nil {
+		return nil, fmt.Errorf("unmarshal changed dashboard: %s", err)
+	}
+	var changes []DashboardChange
+	diffJSON("", a, b, &changes)
+	return changes, nil
+}
+
+func decodeJSON(raw []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func diffJSON(path string, a, b interface{}, changes *[]DashboardChange) {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(av)+len(bv))
+		for k := range av {
+			keys = append(keys, k)
+		}
+		for k := range bv {
+			if _, ok := av[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			sub := k
+			if path != "" {
+				sub = path + "." + k
+			}
+			diffJSON(sub, av[k], bv[k], changes)
+		}
+		return
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(av) || i < len(bv); i++ {
+			var x, y interface{}
+			if i < len(av) {
+				x = av[i]
+			}
+			if i < len(bv) {
+				y = bv[i]
+			}
+			diffJSON(path+"["+strconv.Itoa(i)+"]", x, y, changes)
+		}
+		return
+	}
+	if !reflect.DeepEqual(a, b) {
+		*changes = append(*changes, DashboardChange{Path: path, Old: a, New: b})
+	}
+}
+
+func diffValue(v interface{}) string {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(raw)
+}
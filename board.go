@@ -238,3 +238,28 @@ func (b *Board) UpdateSlug() string {
 	b.Slug = strings.ToLower(slug.Make(b.Title))
 	return b.Slug
 }
+
+// forEachPanel calls fn for every panel of the board including panels
+// of collapsed rows and of the legacy rows. It stops on the first error.
+func (b *Board) forEachPanel(fn func(*Panel) error) error {
+	for _, p := range b.Panels {
+		if err := fn(p); err != nil {
+			return err
+		}
+		if p.RowPanel != nil {
+			for i := range p.RowPanel.Panels {
+				if err := fn(&p.RowPanel.Panels[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, row := range b.Rows {
+		for i := range row.Panels {
+			if err := fn(&row.Panels[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
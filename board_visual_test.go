@@ -79,7 +79,7 @@ func TestSinglestatPanel(t *testing.T) {
 
 	var res string
 
-	fullAddr := fmt.Sprintf("http://%s%s", "localhost:3000", *db.URL)
+	fullAddr := fmt.Sprintf("http://%s%s", "localhost:3000", db.URL)
 	t.Logf("Got Grafana's URL: %s", fullAddr)
 
 	err = chromedp.Run(ctx,
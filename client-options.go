@@ -0,0 +1,149 @@
+package sdk
+
+/*
+   Copyright 2016 Alexander I.Grafov <grafov@gmail.com>
+   Copyright 2016-2019 The Grafana SDK authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+	   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+
+   ॐ तारे तुत्तारे तुरे स्व
+*/
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// DefaultUserAgent is sent with requests unless WithUserAgent option used.
+const DefaultUserAgent = "autograf"
+
+// ClientOption is a type for specifying options of NewClientWithOptions.
+type ClientOption func(*Client)
+
+// NewClientWithOptions initializes client for interacting with an instance
+// of Grafana server. Without options the client uses anonymous access
+// and DefaultHTTPClient.
+func NewClientWithOptions(apiURL string, opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL:       apiURL,
+		anonymousAuth: true,
+		client:        DefaultHTTPClient,
+		userAgent:     DefaultUserAgent,
+		headers:       make(http.Header),
+		version:       &serverVersion{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithAPIKey sets Grafana API key or service account token for authentication.
+func WithAPIKey(key string) ClientOption {
+	return func(c *Client) {
+		c.key = fmt.Sprintf("Bearer %s", key)
+		c.basicAuth = false
+		c.anonymousAuth = false
+	}
+}
+
+// WithBasicAuth sets credentials for basic authentication.
+func WithBasicAuth(user, password string) ClientOption {
+	return func(c *Client) {
+		baseURL, err := url.Parse(c.baseURL)
+		if err != nil {
+			return
+		}
+		baseURL.User = url.UserPassword(user, password)
+		c.baseURL = baseURL.String()
+		c.key = ""
+		c.basicAuth = true
+		c.anonymousAuth = false
+	}
+}
+
+// WithHTTPClient sets HTTP client used for requests.
+// Nil value is silently ignored.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *Client) {
+		if client != nil {
+			c.client = client
+		}
+	}
+}
+
+// WithDebug enables logging of requests and responses to stdout
+// with LogHooks. Values of the static headers set with WithHeader are
+// redacted as they usually carry credentials of proxies.
+func WithDebug(debug bool) ClientOption {
+	return func(c *Client) {
+		if debug {
+			static := func(name string) bool { return len(c.headers[name]) > 0 }
+			c.hooks = append(c.hooks, logHooks(log.New(os.Stdout, "grafana-sdk: ", log.LstdFlags), static))
+		}
+	}
+}
+
+// WithHeader adds the static header to each request.
+// Can be specified multiple times, the values for the same key are accumulated.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithUserAgent replaces DefaultUserAgent in requests.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithOrgID scopes requests to the organization by sending
+// X-Grafana-Org-Id header. Zero value means the current organization
+// of the user.
+func WithOrgID(orgID uint) ClientOption {
+	return func(c *Client) {
+		c.orgID = orgID
+	}
+}
+
+// WithOrg returns a copy of the client which scopes all requests to the
+// organization with orgID by sending X-Grafana-Org-Id header. Contrary to
+// SwitchActualUserContext() and SwitchUserContext() it doesn't change
+// the state of the user on the server so the copies for different
+// organizations could be used concurrently with the same credentials.
+func (r *Client) WithOrg(orgID uint) *Client {
+	c := *r
+	c.orgID = orgID
+	return &c
+}
+
+// WithTimeout limits the time of each request attempt.
+// Zero value means no timeout except the one of HTTP client.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithRetryPolicy sets the policy for repeating of failed requests.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
@@ -0,0 +1,97 @@
+package sdk_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bdunavant/sdk"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	var got *http.Request
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+	client := sdk.NewClientWithOptions(ts.URL,
+		sdk.WithHTTPClient(ts.Client()),
+		sdk.WithAPIKey("secret"),
+		sdk.WithHeader("X-Proxy-Token", "proxy"),
+		sdk.WithUserAgent("sdk-test"),
+		sdk.WithOrgID(3),
+		sdk.WithTimeout(time.Second),
+	)
+	if _, err := client.Search(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for header, expected := range map[string]string{
+		"Authorization":    "Bearer secret",
+		"X-Proxy-Token":    "proxy",
+		"User-Agent":       "sdk-test",
+		"X-Grafana-Org-Id": "3",
+	} {
+		if value := got.Header.Get(header); value != expected {
+			t.Errorf("expected %s header to be %q, but was %q", header, expected, value)
+		}
+	}
+}
+
+func TestNewClient_BasicAuth(t *testing.T) {
+	var got *http.Request
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+	client := sdk.NewClient(ts.URL, "admin:pass:word", ts.Client(), false)
+	if _, err := client.Search(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	user, password, ok := got.BasicAuth()
+	if !ok || user != "admin" || password != "pass:word" {
+		t.Errorf("unexpected basic auth credentials %q:%q", user, password)
+	}
+	if ua := got.Header.Get("User-Agent"); ua != sdk.DefaultUserAgent {
+		t.Errorf("expected default user agent, got %q", ua)
+	}
+	if org := got.Header.Get("X-Grafana-Org-Id"); org != "" {
+		t.Errorf("expected no org header, got %q", org)
+	}
+}
+
+func TestClient_WithOrg(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name":"` + r.Header.Get("X-Grafana-Org-Id") + `"}]`))
+	}))
+	defer ts.Close()
+	client := sdk.NewClient(ts.URL, "admin:admin", ts.Client(), false)
+	var wg sync.WaitGroup
+	for org := uint(1); org <= 5; org++ {
+		wg.Add(1)
+		go func(org uint) {
+			defer wg.Done()
+			ds, err := client.WithOrg(org).GetAllDatasources(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if expected := strconv.FormatUint(uint64(org), 10); len(ds) != 1 || ds[0].Name != expected {
+				t.Errorf("expected request scoped to org %s, got %v", expected, ds)
+			}
+		}(org)
+	}
+	wg.Wait()
+	ds, err := client.GetAllDatasources(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ds[0].Name != "" {
+		t.Errorf("expected the original client not to be scoped, got org %s", ds[0].Name)
+	}
+}
@@ -37,8 +37,7 @@ import (
 func main() {
 	var (
 		boardLinks []sdk.FoundBoard
-		rawBoard   []byte
-		meta       sdk.BoardProperties
+		uids       []string
 		err        error
 	)
 	if len(os.Args) != 3 {
@@ -47,17 +46,21 @@ func main() {
 	}
 	ctx := context.Background()
 	c := sdk.NewClient(os.Args[1], os.Args[2], sdk.DefaultHTTPClient, false)
-	if boardLinks, err = c.SearchDashboards(ctx, "", false); err != nil {
+	c.SetRetryPolicy(sdk.DefaultRetryPolicy)
+	if boardLinks, err = c.SearchAll(ctx, sdk.SearchType(sdk.SearchTypeDashboard)); err != nil {
 		fmt.Fprint(os.Stderr, err)
 		os.Exit(1)
 	}
 	for _, link := range boardLinks {
-		if rawBoard, meta, err = c.GetRawDashboardBySlug(ctx, link.URI); err != nil {
-			fmt.Fprintf(os.Stderr, "%s for %s\n", err, link.URI)
+		uids = append(uids, link.UID)
+	}
+	for res := range c.GetDashboardsByUIDs(ctx, uids, 8) {
+		if res.Err != nil {
+			fmt.Fprintf(os.Stderr, "%s for %s\n", res.Err, res.UID)
 			continue
 		}
-		if err = ioutil.WriteFile(fmt.Sprintf("%s.json", meta.Slug), rawBoard, os.FileMode(int(0666))); err != nil {
-			fmt.Fprintf(os.Stderr, "%s for %s\n", err, meta.Slug)
+		if err = ioutil.WriteFile(fmt.Sprintf("%s.json", res.Meta.Slug), res.Raw, os.FileMode(int(0666))); err != nil {
+			fmt.Fprintf(os.Stderr, "%s for %s\n", err, res.Meta.Slug)
 		}
 	}
 }
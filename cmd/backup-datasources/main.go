@@ -49,6 +49,7 @@ func main() {
 	}
 	ctx := context.Background()
 	c := sdk.NewClient(os.Args[1], os.Args[2], sdk.DefaultHTTPClient, false)
+	c.SetRetryPolicy(sdk.DefaultRetryPolicy)
 	if datasources, err = c.GetAllDatasources(ctx); err != nil {
 		fmt.Fprint(os.Stderr, err)
 		os.Exit(1)
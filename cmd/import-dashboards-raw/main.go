@@ -45,12 +45,22 @@ func main() {
 	var (
 		filesInDir []os.FileInfo
 		rawBoard   []byte
+		inputs     []sdk.ImportDashboardInput
+		values     = make(map[string]string)
 		err        error
 	)
-	if len(os.Args) != 3 {
-		fmt.Fprint(os.Stderr, "Usage: import-dashboards http://grafana.host:3000 api-key-string-here\n")
+	if len(os.Args) < 3 {
+		fmt.Fprint(os.Stderr, "Usage: import-dashboards-raw http://grafana.host:3000 api-key-string-here [INPUT_NAME=value ...]\n")
 		os.Exit(0)
 	}
+	// values of __inputs for the dashboards exported for sharing externally
+	for _, arg := range os.Args[3:] {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 {
+			log.Fatalf("invalid input value %q, NAME=value expected", arg)
+		}
+		values[parts[0]] = parts[1]
+	}
 	ctx := context.Background()
 	c := sdk.NewClient(os.Args[1], os.Args[2], sdk.DefaultHTTPClient, false)
 	filesInDir, err = ioutil.ReadDir(".")
@@ -63,7 +73,15 @@ func main() {
 				log.Println(err)
 				continue
 			}
-			_, err := c.SetRawDashboard(ctx, rawBoard)
+			if inputs, err = sdk.NewImportInputs(rawBoard, values); err != nil {
+				log.Printf("error on importing dashboard from %s: %s", file.Name(), err)
+				continue
+			}
+			if len(inputs) > 0 {
+				_, err = c.ImportDashboard(ctx, sdk.ImportDashboardRequest{Dashboard: rawBoard, Overwrite: true, Inputs: inputs})
+			} else {
+				_, err = c.SetRawDashboard(ctx, rawBoard)
+			}
 			if err != nil {
 				log.Printf("error on importing dashboard from %s", file.Name())
 				continue
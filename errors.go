@@ -0,0 +1,97 @@
+package sdk
+
+/*
+   Copyright 2016 Alexander I.Grafov <grafov@gmail.com>
+   Copyright 2016-2019 The Grafana SDK authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+	   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+
+   ॐ तारे तुत्तारे तुरे स्व
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors for checking the class of an APIError with errors.Is.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrVersionMismatch = errors.New("version mismatch")
+	ErrNameExists      = errors.New("name exists")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrForbidden       = errors.New("forbidden")
+)
+
+// APIError is returned by the client methods when Grafana answers
+// with a status code outside of 2xx range.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Endpoint   string
+	// Message is the "message" field decoded from the Grafana reply.
+	Message string
+	// Status is the "status" field decoded from the Grafana reply
+	// (for example "version-mismatch"), it is empty for most of errors.
+	Status string
+	// Body keeps the raw reply as it returned by Grafana.
+	Body []byte
+}
+
+func newAPIError(method, endpoint string, code int, body []byte) *APIError {
+	var reply struct {
+		Message string `json:"message"`
+		Status  string `json:"status"`
+	}
+	// Grafana not always answers with JSON so the decoding error is ignored
+	// and raw body is used for the error message instead.
+	_ = json.Unmarshal(body, &reply)
+	return &APIError{
+		StatusCode: code,
+		Method:     method,
+		Endpoint:   endpoint,
+		Message:    reply.Message,
+		Status:     reply.Status,
+		Body:       body,
+	}
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = string(e.Body)
+	}
+	return fmt.Sprintf("HTTP error %d on %s %s: %s", e.StatusCode, e.Method, e.Endpoint, msg)
+}
+
+// Is allows to check the error against the sentinel errors with errors.Is().
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrVersionMismatch:
+		return e.StatusCode == http.StatusPreconditionFailed && e.Status == "version-mismatch"
+	case ErrNameExists:
+		return e.StatusCode == http.StatusPreconditionFailed && e.Status == "name-exists"
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
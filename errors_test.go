@@ -0,0 +1,68 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+)
+
+func TestAPIError(t *testing.T) {
+	type testCase struct {
+		Code     int
+		Body     string
+		Sentinel error
+	}
+	for i, tc := range []testCase{
+		{Code: http.StatusNotFound, Body: `{"message":"Dashboard not found"}`, Sentinel: sdk.ErrNotFound},
+		{Code: http.StatusConflict, Body: `{"message":"Folder already exists"}`, Sentinel: sdk.ErrConflict},
+		{Code: http.StatusPreconditionFailed, Body: `{"message":"The dashboard has been changed by someone else","status":"version-mismatch"}`, Sentinel: sdk.ErrVersionMismatch},
+		{Code: http.StatusPreconditionFailed, Body: `{"message":"A dashboard with the same name in the folder already exists","status":"name-exists"}`, Sentinel: sdk.ErrNameExists},
+		{Code: http.StatusUnauthorized, Body: `{"message":"Unauthorized"}`, Sentinel: sdk.ErrUnauthorized},
+		{Code: http.StatusForbidden, Body: `Permission denied`, Sentinel: sdk.ErrForbidden},
+	} {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.Code)
+			w.Write([]byte(tc.Body))
+		}))
+		client := sdk.NewClient(ts.URL, "", ts.Client(), false)
+		_, _, err := client.GetDashboardByUID(context.Background(), "uid")
+		ts.Close()
+		if !errors.Is(err, tc.Sentinel) {
+			t.Errorf("case %d: expected error to match %q, got %v", i, tc.Sentinel, err)
+		}
+		var apiErr *sdk.APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("case %d: expected *sdk.APIError, got %T", i, err)
+		}
+		if apiErr.StatusCode != tc.Code {
+			t.Errorf("case %d: expected status code %d, got %d", i, tc.Code, apiErr.StatusCode)
+		}
+		if apiErr.Method != http.MethodGet || apiErr.Endpoint != "/api/dashboards/uid/uid" {
+			t.Errorf("case %d: unexpected request in error: %s %s", i, apiErr.Method, apiErr.Endpoint)
+		}
+		if string(apiErr.Body) != tc.Body {
+			t.Errorf("case %d: expected body %s, got %s", i, tc.Body, apiErr.Body)
+		}
+	}
+}
+
+func TestAPIError_IgnoredStatusCode(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"Dashboard not found"}`))
+	}))
+	defer ts.Close()
+	client := sdk.NewClient(ts.URL, "", ts.Client(), false)
+	_, err := client.DeleteDashboardByUID(context.Background(), "uid")
+	if !errors.Is(err, sdk.ErrNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	var apiErr *sdk.APIError
+	if errors.As(err, &apiErr) && apiErr.Message != "Dashboard not found" {
+		t.Errorf("expected decoded message, got %q", apiErr.Message)
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
 )
 
 func getDebugURL(t *testing.T) string {
@@ -46,17 +48,41 @@ func getFullUrl(t *testing.T) (addr, user, pass string) {
 	return
 }
 
+// getClient returns the client for the integration test. With
+// GRAFANA_RECORD=1 the exchanges with Grafana are recorded to the
+// cassette of the test. Without GRAFANA_INTEGRATION=1 the test runs
+// offline against the recorded cassette.
 func getClient(t *testing.T) *sdk.Client {
 	t.Helper()
 	addr, user, pass := getFullUrl(t)
 
-	return sdk.NewClient(addr, fmt.Sprintf("%s:%s", user, pass), sdk.DefaultHTTPClient, false)
+	mode := sdktest.ModeReplay
+	if os.Getenv("GRAFANA_INTEGRATION") == "1" {
+		if os.Getenv("GRAFANA_RECORD") != "1" {
+			return sdk.NewClient(addr, fmt.Sprintf("%s:%s", user, pass), sdk.DefaultHTTPClient, false)
+		}
+		mode = sdktest.ModeRecord
+	}
+	rec, err := sdktest.NewRecorder(cassettePath(t), mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sdk.NewClient(addr, fmt.Sprintf("%s:%s", user, pass), &http.Client{Transport: rec}, false)
+}
+
+func cassettePath(t *testing.T) string {
+	return filepath.Join("testdata", "cassettes", t.Name()+".json")
 }
 
+// shouldSkip skips the integration test unless GRAFANA_INTEGRATION=1
+// or the test has the recorded cassette.
 func shouldSkip(t *testing.T) {
 	t.Helper()
 
 	if v := os.Getenv("GRAFANA_INTEGRATION"); v != "1" {
-		t.Skipf("skipping because GRAFANA_INTEGRATION is %s, not 1", v)
+		if _, err := os.Stat(cassettePath(t)); err == nil {
+			return
+		}
+		t.Skipf("skipping because GRAFANA_INTEGRATION is %s, not 1, and no cassette recorded", v)
 	}
 }
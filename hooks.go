@@ -0,0 +1,188 @@
+package sdk
+
+/*
+   Copyright 2016 Alexander I.Grafov <grafov@gmail.com>
+   Copyright 2016-2019 The Grafana SDK authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+	   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+
+   ॐ तारे तुत्तारे तुरे स्व
+*/
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Hooks are called by the client around each attempt of a request.
+// Any of the functions may be nil. Hooks must not read req.Body,
+// the body of the request is passed separately.
+type Hooks struct {
+	// BeforeRequest is called before sending the request. It may
+	// modify the request, for example add tracing headers.
+	BeforeRequest func(req *http.Request, body []byte)
+	// AfterResponse is called when a response received regardless
+	// of its status code. The response body is already read and
+	// passed as data.
+	AfterResponse func(req *http.Request, resp *http.Response, data []byte, elapsed time.Duration)
+	// OnError is called when the request failed without a response,
+	// for example because of a network error or timeout.
+	OnError func(req *http.Request, err error, elapsed time.Duration)
+}
+
+// WithHooks appends the hooks to the chain of the client.
+// Hooks are called in the order they were added.
+func WithHooks(hooks Hooks) ClientOption {
+	return func(c *Client) {
+		c.hooks = append(c.hooks, hooks)
+	}
+}
+
+// redacted replaces values of sensitive data in the log output.
+const redacted = "[REDACTED]"
+
+// sensitiveFields lists JSON keys whose values are never logged.
+var sensitiveFields = map[string]bool{
+	"password":          true,
+	"basicAuthPassword": true,
+	"secureJsonData":    true,
+	"oldPassword":       true,
+	"newPassword":       true,
+	"key":               true,
+	"deleteKey":         true,
+}
+
+// sensitiveHeaders lists headers whose values are never logged.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// credentialWords mark names of headers carrying credentials such as
+// X-Api-Key or X-Proxy-Token.
+var credentialWords = []string{"token", "key", "secret", "password", "session", "auth"}
+
+// LogHooks returns hooks that log requests and responses with the logger
+// as key=value lines. Credentials are redacted: Authorization and cookie
+// headers, headers named like tokens or keys, password of basic
+// authentication and sensitive fields of JSON bodies such as password and
+// secureJsonData. Values of the headers listed in sensitive are redacted
+// too.
+func LogHooks(logger *log.Logger, sensitive ...string) Hooks {
+	extra := make(map[string]bool, len(sensitive))
+	for _, name := range sensitive {
+		extra[http.CanonicalHeaderKey(name)] = true
+	}
+	return logHooks(logger, func(name string) bool { return extra[name] })
+}
+
+// logHooks implements LogHooks, the values of the headers reported by
+// sensitive are redacted in addition to the default ones.
+func logHooks(logger *log.Logger, sensitive func(name string) bool) Hooks {
+	return Hooks{
+		BeforeRequest: func(req *http.Request, body []byte) {
+			logger.Printf("request method=%s url=%q headers=%q body=%q",
+				req.Method, redactURL(req.URL), redactHeaders(req.Header, sensitive), redactBody(body))
+		},
+		AfterResponse: func(req *http.Request, resp *http.Response, data []byte, elapsed time.Duration) {
+			logger.Printf("response method=%s url=%q status=%d elapsed=%s body=%q",
+				req.Method, redactURL(req.URL), resp.StatusCode, elapsed, redactBody(data))
+		},
+		OnError: func(req *http.Request, err error, elapsed time.Duration) {
+			logger.Printf("error method=%s url=%q elapsed=%s error=%q",
+				req.Method, redactURL(req.URL), elapsed, err)
+		},
+	}
+}
+
+func redactURL(u *url.URL) string {
+	if u.User == nil {
+		return u.String()
+	}
+	c := *u
+	if _, ok := c.User.Password(); ok {
+		c.User = url.UserPassword(c.User.Username(), redacted)
+	}
+	return c.String()
+}
+
+func redactHeaders(h http.Header, sensitive func(name string) bool) string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var out []string
+	for _, k := range keys {
+		value := strings.Join(h[k], ",")
+		if value != "" && (IsSensitiveHeader(k) || sensitive(k)) {
+			value = redacted
+		}
+		out = append(out, k+": "+value)
+	}
+	return strings.Join(out, "; ")
+}
+
+// IsSensitiveHeader reports whether the header carries credentials
+// judging by its name: Authorization, cookies and the headers named like
+// tokens, keys or secrets such as X-Api-Key. LogHooks redacts their
+// values and sdktest cassettes don't keep them.
+func IsSensitiveHeader(name string) bool {
+	if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+		return true
+	}
+	name = strings.ToLower(name)
+	for _, word := range credentialWords {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactBody(body []byte) string {
+	var plain interface{}
+	if len(body) == 0 || json.Unmarshal(body, &plain) != nil {
+		return string(body)
+	}
+	out, err := json.Marshal(redactValue(plain))
+	if err != nil {
+		return string(body)
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, field := range value {
+			if sensitiveFields[k] {
+				value[k] = redacted
+				continue
+			}
+			value[k] = redactValue(field)
+		}
+	case []interface{}:
+		for i := range value {
+			value[i] = redactValue(value[i])
+		}
+	}
+	return v
+}
@@ -0,0 +1,90 @@
+package sdk_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bdunavant/sdk"
+)
+
+func TestLogHooks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":1,"message":"Datasource added"}`))
+	}))
+	defer ts.Close()
+	var out bytes.Buffer
+	client := sdk.NewClientWithOptions(ts.URL,
+		sdk.WithHTTPClient(ts.Client()),
+		sdk.WithBasicAuth("admin", "topsecret"),
+		sdk.WithHeader("Cookie", "grafana_session=cookiesecret"),
+		sdk.WithHeader("X-Proxy-Token", "proxysecret"),
+		sdk.WithHeader("X-Tenant", "tenantsecret"),
+		sdk.WithHeader("X-Trace", "visible"),
+		sdk.WithHooks(sdk.LogHooks(log.New(&out, "", 0), "x-tenant")))
+	password := "dbsecret"
+	ds := sdk.Datasource{
+		Name:           "ds",
+		Password:       &password,
+		SecureJSONData: map[string]string{"token": "apisecret"},
+	}
+	if _, err := client.CreateDatasource(context.Background(), ds); err != nil {
+		t.Fatal(err)
+	}
+	logged := out.String()
+	for _, secret := range []string{"topsecret", "dbsecret", "apisecret", "cookiesecret", "proxysecret", "tenantsecret"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("expected %s to be redacted in the log:\n%s", secret, logged)
+		}
+	}
+	for _, expected := range []string{"request method=POST", "response method=POST", "status=200", "Datasource added", "X-Trace: visible"} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("expected %q in the log:\n%s", expected, logged)
+		}
+	}
+}
+
+func TestHooks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(r.Header.Get("X-Request-Id")))
+	}))
+	var (
+		statuses []int
+		errs     []error
+	)
+	client := sdk.NewClientWithOptions(ts.URL,
+		sdk.WithHTTPClient(ts.Client()),
+		sdk.WithHooks(sdk.Hooks{
+			BeforeRequest: func(req *http.Request, body []byte) {
+				req.Header.Set("X-Request-Id", "42")
+			},
+			AfterResponse: func(req *http.Request, resp *http.Response, data []byte, elapsed time.Duration) {
+				statuses = append(statuses, resp.StatusCode)
+			},
+			OnError: func(req *http.Request, err error, elapsed time.Duration) {
+				errs = append(errs, err)
+			},
+		}))
+	_, err := client.GetAllFolders(context.Background())
+	var apiErr *sdk.APIError
+	if !errors.As(err, &apiErr) || string(apiErr.Body) != "42" {
+		t.Fatalf("expected the request to be modified by the hook, got %v", err)
+	}
+	ts.Close()
+	if _, err = client.GetAllFolders(context.Background()); err == nil {
+		t.Fatal("expected an error for the closed server")
+	}
+	if len(statuses) != 1 || statuses[0] != http.StatusNotFound {
+		t.Errorf("expected one response with 404 status, got %v", statuses)
+	}
+	if len(errs) != 1 {
+		t.Errorf("expected one transport error, got %v", errs)
+	}
+}
@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Kinds of library elements.
+const (
+	LibraryElementPanel    = 1
+	LibraryElementVariable = 2
+)
+
+// LibraryElement is the library panel or variable shared between
+// dashboards. Model is the JSON of the panel.
+type LibraryElement struct {
+	ID          uint               `json:"id"`
+	OrgID       uint               `json:"orgId"`
+	FolderID    int                `json:"folderId"`
+	FolderUID   string             `json:"folderUid,omitempty"`
+	UID         string             `json:"uid"`
+	Name        string             `json:"name"`
+	Kind        int                `json:"kind"`
+	Type        string             `json:"type"`
+	Description string             `json:"description"`
+	Model       json.RawMessage    `json:"model"`
+	Version     int                `json:"version"`
+	Meta        LibraryElementMeta `json:"meta"`
+}
+
+// LibraryElementMeta keeps metadata of the library element.
+type LibraryElementMeta struct {
+	FolderName          string             `json:"folderName"`
+	FolderUID           string             `json:"folderUid"`
+	ConnectedDashboards int                `json:"connectedDashboards"`
+	Created             time.Time          `json:"created"`
+	Updated             time.Time          `json:"updated"`
+	CreatedBy           LibraryElementUser `json:"createdBy"`
+	UpdatedBy           LibraryElementUser `json:"updatedBy"`
+}
+
+// LibraryElementUser is the user created or updated the library element.
+type LibraryElementUser struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatarUrl"`
+}
+
+// PageLibraryElements is a page of the library elements search result.
+type PageLibraryElements struct {
+	TotalCount int              `json:"totalCount"`
+	Elements   []LibraryElement `json:"elements"`
+	Page       int              `json:"page"`
+	PerPage    int              `json:"perPage"`
+}
+
+// LibraryElementConnection is the dashboard using the library element.
+type LibraryElementConnection struct {
+	ID            uint      `json:"id"`
+	Kind          int       `json:"kind"`
+	ElementID     uint      `json:"elementId"`
+	ConnectionID  uint      `json:"connectionId"`
+	ConnectionUID string    `json:"connectionUid"`
+	Created       time.Time `json:"created"`
+}
+
+// CreateLibraryElementRequest is a request to create a new library element.
+// Grafana generates the uid unless it is set.
+type CreateLibraryElementRequest struct {
+	UID       string          `json:"uid,omitempty"`
+	FolderID  int             `json:"folderId,omitempty"`
+	FolderUID string          `json:"folderUid,omitempty"`
+	Name      string          `json:"name"`
+	Kind      int             `json:"kind"`
+	Model     json.RawMessage `json:"model"`
+}
+
+// UpdateLibraryElementRequest is a request to update the library element.
+// Version must be the actual version of the element.
+type UpdateLibraryElementRequest struct {
+	UID       string          `json:"uid,omitempty"`
+	FolderID  int             `json:"folderId,omitempty"`
+	FolderUID string          `json:"folderUid,omitempty"`
+	Name      string          `json:"name,omitempty"`
+	Kind      int             `json:"kind"`
+	Model     json.RawMessage `json:"model,omitempty"`
+	Version   int             `json:"version"`
+}
+
+// NewLibraryPanelRequest makes the request for creating the library panel
+// from the panel. The panel id, position and library panel reference are
+// not the part of the library panel model. The panel title is used when
+// the name is empty.
+func NewLibraryPanelRequest(p Panel, name, folderUID string) (CreateLibraryElementRequest, error) {
+	var (
+		req   = CreateLibraryElementRequest{Name: name, FolderUID: folderUID, Kind: LibraryElementPanel}
+		model map[string]interface{}
+		raw   []byte
+		err   error
+	)
+	if p.OfType == LibraryPanelType {
+		return req, errors.New("the panel is a reference to the library panel")
+	}
+	if req.Name == "" {
+		req.Name = p.Title
+	}
+	if raw, err = json.Marshal(&p); err != nil {
+		return req, err
+	}
+	if err = decodeJSON(raw, &model); err != nil {
+		return req, err
+	}
+	delete(model, "id")
+	delete(model, "gridPos")
+	delete(model, "libraryPanel")
+	req.Model, err = json.Marshal(model)
+	return req, err
+}
+
+// ResolveLibraryPanels replaces references to library panels with the
+// panels loaded from the library, so the board could be inspected
+// offline. The panel id, position and the reference are kept, so the
+// board still could be saved back as is.
+func (b *Board) ResolveLibraryPanels(ctx context.Context, c *Client) error {
+	var (
+		models = make(map[string]json.RawMessage)
+		err    error
+	)
+	resolve := func(p *Panel) error {
+		if p.LibraryPanel == nil {
+			return nil
+		}
+		uid := p.LibraryPanel.UID
+		model, ok := models[uid]
+		if !ok {
+			var el LibraryElement
+			if el, err = c.GetLibraryElement(ctx, uid); err != nil {
+				return fmt.Errorf("get library panel %s: %w", uid, err)
+			}
+			model = el.Model
+			models[uid] = model
+		}
+		var resolved Panel
+		if err = json.Unmarshal(model, &resolved); err != nil {
+			return fmt.Errorf("unmarshal library panel %s: %w", uid, err)
+		}
+		resolved.ID = p.ID
+		resolved.GridPos = p.GridPos
+		resolved.LibraryPanel = p.LibraryPanel
+		*p = resolved
+		return nil
+	}
+	return b.forEachPanel(resolve)
+}
@@ -0,0 +1,296 @@
+package sdk
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// Iterators below fetch the pages of results lazily while they are
+// consumed. They are used in the same way as bufio.Scanner:
+//
+//	it := client.SearchIterator(ctx, sdk.SearchType(sdk.SearchTypeDashboard))
+//	it.MaxResults = 10000
+//	for it.Next() {
+//		board := it.Board()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+//
+// Iteration stops on the first error including cancellation of
+// the context.
+
+// DefaultPageSize is the number of results requested per page by
+// the iterators unless their PageSize set.
+const DefaultPageSize = 1000
+
+// SearchIterator iterates over the results of Search.
+type SearchIterator struct {
+	// PageSize is the number of results requested per call,
+	// Grafana limits it with 5000.
+	PageSize int
+	// MaxResults caps the total number of results, zero means
+	// no limit.
+	MaxResults int
+
+	ctx    context.Context
+	client *Client
+	params []SearchParam
+	page   int
+	buf    []FoundBoard
+	cur    FoundBoard
+	count  int
+	last   bool
+	err    error
+}
+
+// SearchIterator returns the iterator over all results of the search.
+// SearchLimit and SearchPage params are overridden by the iterator.
+func (r *Client) SearchIterator(ctx context.Context, params ...SearchParam) *SearchIterator {
+	return &SearchIterator{PageSize: DefaultPageSize, ctx: ctx, client: r, params: params}
+}
+
+// Next advances the iterator to the next result. It returns false when
+// the results are exhausted, MaxResults reached or an error occurred.
+func (it *SearchIterator) Next() bool {
+	if it.err != nil || (it.MaxResults > 0 && it.count >= it.MaxResults) {
+		return false
+	}
+	if it.err = it.ctx.Err(); it.err != nil {
+		return false
+	}
+	if len(it.buf) == 0 {
+		if it.last {
+			return false
+		}
+		it.page++
+		// the size must not change between pages to keep offsets
+		size := pageSize(it.PageSize, it.MaxResults, 0)
+		params := append(append([]SearchParam{}, it.params...), SearchLimit(uint(size)), SearchPage(uint(it.page)))
+		if it.buf, it.err = it.client.Search(it.ctx, params...); it.err != nil {
+			return false
+		}
+		it.last = len(it.buf) < size
+		if len(it.buf) == 0 {
+			return false
+		}
+	}
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	it.count++
+	return true
+}
+
+// Board returns the current result.
+func (it *SearchIterator) Board() FoundBoard {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration.
+func (it *SearchIterator) Err() error {
+	return it.err
+}
+
+// SearchAll searches folders and dashboards with query params specified
+// and returns all results fetching them page by page. Use SearchIterator
+// for limiting the number of results.
+func (r *Client) SearchAll(ctx context.Context, params ...SearchParam) ([]FoundBoard, error) {
+	var (
+		it     = r.SearchIterator(ctx, params...)
+		boards []FoundBoard
+	)
+	for it.Next() {
+		boards = append(boards, it.Board())
+	}
+	return boards, it.Err()
+}
+
+// UserPager iterates over the results of the users search.
+type UserPager struct {
+	// PageSize is the number of users requested per call.
+	PageSize int
+	// MaxResults caps the total number of results, zero means
+	// no limit.
+	MaxResults int
+
+	ctx    context.Context
+	client *Client
+	query  *string
+	page   int
+	buf    []User
+	cur    User
+	count  int
+	total  int
+	last   bool
+	err    error
+}
+
+// UserPager returns the iterator over users found by the query,
+// empty query means all users.
+// It uses GET /api/users/search API call.
+func (r *Client) UserPager(ctx context.Context, query string) *UserPager {
+	p := &UserPager{PageSize: DefaultPageSize, ctx: ctx, client: r}
+	if query != "" {
+		p.query = &query
+	}
+	return p
+}
+
+// Next advances the pager to the next user. It returns false when
+// the users are exhausted, MaxResults reached or an error occurred.
+func (p *UserPager) Next() bool {
+	if p.err != nil || (p.MaxResults > 0 && p.count >= p.MaxResults) {
+		return false
+	}
+	if p.err = p.ctx.Err(); p.err != nil {
+		return false
+	}
+	if len(p.buf) == 0 {
+		if p.last {
+			return false
+		}
+		p.page++
+		var (
+			size   = pageSize(p.PageSize, p.MaxResults, 0)
+			page   = p.page
+			result PageUsers
+		)
+		if result, p.err = p.client.SearchUsersWithPaging(p.ctx, p.query, &size, &page); p.err != nil {
+			return false
+		}
+		p.buf, p.total = result.Users, result.TotalCount
+		p.last = len(p.buf) < size || (p.page*size >= p.total && p.total > 0)
+		if len(p.buf) == 0 {
+			return false
+		}
+	}
+	p.cur, p.buf = p.buf[0], p.buf[1:]
+	p.count++
+	return true
+}
+
+// User returns the current user.
+func (p *UserPager) User() User {
+	return p.cur
+}
+
+// TotalCount returns the total number of found users as reported
+// with the last fetched page.
+func (p *UserPager) TotalCount() int {
+	return p.total
+}
+
+// Err returns the error that stopped the iteration.
+func (p *UserPager) Err() error {
+	return p.err
+}
+
+// AnnotationIterator iterates over the results of GetAnnotations.
+// Grafana has no pages for annotations, so the iterator walks back in
+// time: each next request asks for annotations not later than the
+// oldest one already returned.
+type AnnotationIterator struct {
+	// PageSize is the number of annotations requested per call.
+	PageSize int
+	// MaxResults caps the total number of results, zero means
+	// no limit.
+	MaxResults int
+
+	ctx      context.Context
+	client   *Client
+	params   []GetAnnotationsParams
+	to       int64
+	boundary int
+	seen     map[uint]bool
+	buf      []AnnotationResponse
+	cur      AnnotationResponse
+	count    int
+	last     bool
+	err      error
+}
+
+// AnnotationIterator returns the iterator over annotations matched by
+// the params from the newest to the oldest. WithLimit param is
+// overridden by the iterator.
+func (r *Client) AnnotationIterator(ctx context.Context, params ...GetAnnotationsParams) *AnnotationIterator {
+	return &AnnotationIterator{PageSize: DefaultPageSize, ctx: ctx, client: r, params: params, seen: make(map[uint]bool)}
+}
+
+// Next advances the iterator to the next annotation. It returns false
+// when the annotations are exhausted, MaxResults reached or an error
+// occurred.
+func (it *AnnotationIterator) Next() bool {
+	if it.err != nil || (it.MaxResults > 0 && it.count >= it.MaxResults) {
+		return false
+	}
+	if it.err = it.ctx.Err(); it.err != nil {
+		return false
+	}
+	if len(it.buf) == 0 {
+		if it.last {
+			return false
+		}
+		var (
+			// annotations with the time of the cursor are returned
+			// again, so the page is extended by their number
+			size   = pageSize(it.PageSize, it.MaxResults, 0) + it.boundary
+			params = append(append([]GetAnnotationsParams{}, it.params...), WithLimit(uint(size)))
+			found  []AnnotationResponse
+		)
+		if it.to != 0 {
+			to := it.to
+			params = append(params, func(v url.Values) {
+				// Grafana filters by time only when both from and to are set
+				if v.Get("from") == "" {
+					v.Set("from", "1")
+				}
+				v.Set("to", strconv.FormatInt(to, 10))
+			})
+		}
+		if found, it.err = it.client.GetAnnotations(it.ctx, params...); it.err != nil {
+			return false
+		}
+		it.last = len(found) < size
+		for _, a := range found {
+			if it.seen[a.ID] {
+				continue
+			}
+			it.seen[a.ID] = true
+			it.buf = append(it.buf, a)
+			switch {
+			case it.to == 0 || a.Time < it.to:
+				it.to, it.boundary = a.Time, 1
+			case a.Time == it.to:
+				it.boundary++
+			}
+		}
+		if len(it.buf) == 0 {
+			return false
+		}
+	}
+	it.cur, it.buf = it.buf[0], it.buf[1:]
+	it.count++
+	return true
+}
+
+// Annotation returns the current annotation.
+func (it *AnnotationIterator) Annotation() AnnotationResponse {
+	return it.cur
+}
+
+// Err returns the error that stopped the iteration.
+func (it *AnnotationIterator) Err() error {
+	return it.err
+}
+
+// pageSize returns the size of the page that doesn't exceed the rest
+// of maxResults after count results.
+func pageSize(size, maxResults, count int) int {
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+	if maxResults > 0 && maxResults-count < size {
+		return maxResults - count
+	}
+	return size
+}
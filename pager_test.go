@@ -0,0 +1,142 @@
+package sdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestSearchIterator(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	for i := 0; i < 7; i++ {
+		if _, err := srv.Client.SetRawDashboard(ctx, []byte(fmt.Sprintf(`{"title":"Board %d"}`, i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	it := srv.Client.SearchIterator(ctx, sdk.SearchType(sdk.SearchTypeDashboard))
+	it.PageSize = 3
+	var titles []string
+	for it.Next() {
+		titles = append(titles, it.Board().Title)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(titles) != 7 || titles[0] != "Board 0" || titles[6] != "Board 6" {
+		t.Errorf("unexpected results %v", titles)
+	}
+
+	it = srv.Client.SearchIterator(ctx)
+	it.PageSize = 2
+	it.MaxResults = 5
+	var n int
+	for it.Next() {
+		n++
+	}
+	if n != 5 || it.Err() != nil {
+		t.Errorf("expected 5 results, got %d with %v", n, it.Err())
+	}
+
+	all, err := srv.Client.SearchAll(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 7 {
+		t.Errorf("expected 7 results, got %d", len(all))
+	}
+}
+
+func TestSearchIterator_Cancel(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`[{"id":1,"title":"A"},{"id":2,"title":"B"}]`))
+	}))
+	defer ts.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()))
+	it := client.SearchIterator(ctx)
+	it.PageSize = 2
+	for it.Next() {
+		if it.Board().ID == 2 {
+			cancel()
+		}
+	}
+	if it.Err() != context.Canceled {
+		t.Errorf("expected canceled context, got %v", it.Err())
+	}
+	if calls != 1 {
+		t.Errorf("expected no requests after cancel, got %d", calls)
+	}
+}
+
+func TestUserPager(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	for i := 0; i < 4; i++ {
+		login := fmt.Sprintf("user%d", i)
+		if _, err := srv.Client.CreateUser(ctx, sdk.User{Login: login, Password: login}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pager := srv.Client.UserPager(ctx, "user")
+	pager.PageSize = 3
+	var logins []string
+	for pager.Next() {
+		logins = append(logins, pager.User().Login)
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(logins) != 4 || pager.TotalCount() != 4 {
+		t.Errorf("unexpected users %v of %d", logins, pager.TotalCount())
+	}
+}
+
+func TestAnnotationIterator(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	// two annotations share the time on the page boundary
+	for _, ts := range []int64{1000, 2000, 2000, 3000, 4000} {
+		if _, err := srv.Client.CreateAnnotation(ctx, sdk.CreateAnnotationRequest{Time: ts, Tags: []string{"deploy"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	it := srv.Client.AnnotationIterator(ctx, sdk.WithTag("deploy"))
+	it.PageSize = 2
+	var times []int64
+	for it.Next() {
+		times = append(times, it.Annotation().Time)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(times) != "[4000 3000 2000 2000 1000]" {
+		t.Errorf("unexpected annotations %v", times)
+	}
+
+	it = srv.Client.AnnotationIterator(ctx)
+	it.PageSize = 2
+	it.MaxResults = 3
+	var n int
+	for it.Next() {
+		n++
+	}
+	if n != 3 {
+		t.Errorf("expected 3 annotations, got %d", n)
+	}
+}
@@ -38,6 +38,9 @@ const (
 	RowType
 	BarGaugeType
 	HeatmapType
+	// LibraryPanelType is the reference to the library panel without the
+	// panel model, see CommonPanel.LibraryPanel.
+	LibraryPanelType
 )
 
 const MixedSource = "-- Mixed --"
@@ -94,6 +97,17 @@ type (
 		Transparent *bool    `json:"transparenti,omitempty"`
 		Type        string   `json:"type"`
 		Alert       *Alert   `json:"alert,omitempty"`
+		// LibraryPanel links the panel to the library panel, see
+		// Board.ResolveLibraryPanels.
+		LibraryPanel *LibraryPanelRef `json:"libraryPanel,omitempty"`
+		// SnapshotData is the data shown by the panel of the snapshot
+		// instead of querying datasources, see Client.EmbedSnapshotData.
+		SnapshotData []DataFrame `json:"snapshotData,omitempty"`
+	}
+	// LibraryPanelRef is the reference to the library panel.
+	LibraryPanelRef struct {
+		UID  string `json:"uid"`
+		Name string `json:"name"`
 	}
 	AlertEvaluator struct {
 		Params []float64 `json:"params,omitempty"`
@@ -924,13 +938,24 @@ func (p *Panel) GetTargets() *[]Target {
 
 type probePanel struct {
 	CommonPanel
-	//	json.RawMessage
+	// Datasource is the name or, since Grafana 8.3, the reference object
+	// which is left in CustomPanel of custom panels only
+	Datasource json.RawMessage `json:"datasource,omitempty"`
 }
 
 func (p *Panel) UnmarshalJSON(b []byte) (err error) {
 	var probe probePanel
 	if err = json.Unmarshal(b, &probe); err == nil {
 		p.CommonPanel = probe.CommonPanel
+		var name string
+		if len(probe.Datasource) > 0 && probe.Datasource[0] == '"' && json.Unmarshal(probe.Datasource, &name) == nil {
+			p.CommonPanel.Datasource = &name
+		}
+		// references to library panels in saved dashboards have no type
+		if probe.Type == "" && probe.LibraryPanel != nil {
+			p.OfType = LibraryPanelType
+			return nil
+		}
 		switch probe.Type {
 		case "graph":
 			var graph GraphPanel
@@ -1065,6 +1090,12 @@ func (p *Panel) MarshalJSON() ([]byte, error) {
 			HeatmapPanel
 		}{p.CommonPanel, *p.HeatmapPanel}
 		return json.Marshal(outHeatmap)
+	case LibraryPanelType:
+		var outRef = struct {
+			CommonPanel
+			Type string `json:"type,omitempty"`
+		}{CommonPanel: p.CommonPanel}
+		return json.Marshal(outRef)
 	case CustomType:
 		var outCustom = struct {
 			CommonPanel
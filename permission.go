@@ -0,0 +1,145 @@
+package sdk
+
+import "time"
+
+// PermissionType is the level of access to a dashboard or folder.
+type PermissionType int
+
+// Permission levels of Grafana.
+const (
+	PermissionView  PermissionType = 1
+	PermissionEdit  PermissionType = 2
+	PermissionAdmin PermissionType = 4
+)
+
+// String returns the name of the permission as Grafana shows it.
+func (p PermissionType) String() string {
+	switch p {
+	case PermissionView:
+		return "View"
+	case PermissionEdit:
+		return "Edit"
+	case PermissionAdmin:
+		return "Admin"
+	}
+	return "Unknown"
+}
+
+// PermissionItem is a single grant of the dashboard or folder ACL.
+// Exactly one of UserID, TeamID or Role selects who gets the permission.
+// Role is one of "Viewer", "Editor" or "Admin".
+type PermissionItem struct {
+	UserID     uint           `json:"userId,omitempty"`
+	TeamID     uint           `json:"teamId,omitempty"`
+	Role       string         `json:"role,omitempty"`
+	Permission PermissionType `json:"permission"`
+
+	// The fields below are set by Grafana in the reply and ignored
+	// on update.
+	ID             uint       `json:"id,omitempty"`
+	DashboardID    int        `json:"dashboardId,omitempty"`
+	FolderID       int        `json:"folderId,omitempty"`
+	UserLogin      string     `json:"userLogin,omitempty"`
+	UserEmail      string     `json:"userEmail,omitempty"`
+	Team           string     `json:"team,omitempty"`
+	TeamEmail      string     `json:"teamEmail,omitempty"`
+	PermissionName string     `json:"permissionName,omitempty"`
+	UID            string     `json:"uid,omitempty"`
+	Title          string     `json:"title,omitempty"`
+	IsFolder       bool       `json:"isFolder,omitempty"`
+	Inherited      bool       `json:"inherited,omitempty"`
+	Created        *time.Time `json:"created,omitempty"`
+	Updated        *time.Time `json:"updated,omitempty"`
+}
+
+// UserPermission returns the grant for the user.
+func UserPermission(userID uint, p PermissionType) PermissionItem {
+	return PermissionItem{UserID: userID, Permission: p}
+}
+
+// TeamPermission returns the grant for the team.
+func TeamPermission(teamID uint, p PermissionType) PermissionItem {
+	return PermissionItem{TeamID: teamID, Permission: p}
+}
+
+// RolePermission returns the grant for the organization role.
+func RolePermission(role string, p PermissionType) PermissionItem {
+	return PermissionItem{Role: role, Permission: p}
+}
+
+// samePrincipal reports whether both grants are given to the same user,
+// team or role.
+func (p PermissionItem) samePrincipal(other PermissionItem) bool {
+	switch {
+	case p.UserID != 0:
+		return p.UserID == other.UserID
+	case p.TeamID != 0:
+		return p.TeamID == other.TeamID
+	}
+	return p.Role != "" && p.Role == other.Role && other.UserID == 0 && other.TeamID == 0
+}
+
+// MergePermission returns the ACL with the grant added or, if the user,
+// team or role of the grant already has own permission, replaced. Zero
+// Permission of the grant removes the permission of its user, team or
+// role. Other grants are kept untouched. Inherited grants are dropped
+// because Grafana doesn't accept them on update.
+func MergePermission(items []PermissionItem, grant PermissionItem) []PermissionItem {
+	var (
+		out   = make([]PermissionItem, 0, len(items)+1)
+		found bool
+	)
+	for _, item := range items {
+		if item.Inherited {
+			continue
+		}
+		if !grant.samePrincipal(item) {
+			out = append(out, item)
+			continue
+		}
+		if !found && grant.Permission != 0 {
+			out = append(out, grant)
+		}
+		found = true
+	}
+	if !found && grant.Permission != 0 {
+		out = append(out, grant)
+	}
+	return out
+}
+
+// RemovePermission returns the ACL without own permission of the user,
+// team or role of the grant. It's a shortcut for MergePermission with
+// zero Permission.
+func RemovePermission(items []PermissionItem, grant PermissionItem) []PermissionItem {
+	grant.Permission = 0
+	return MergePermission(items, grant)
+}
+
+// permissionUpdate is the body of ACL update requests.
+type permissionUpdate struct {
+	Items []permissionUpdateItem `json:"items"`
+}
+
+type permissionUpdateItem struct {
+	UserID     uint           `json:"userId,omitempty"`
+	TeamID     uint           `json:"teamId,omitempty"`
+	Role       string         `json:"role,omitempty"`
+	Permission PermissionType `json:"permission"`
+}
+
+func newPermissionUpdate(items []PermissionItem) permissionUpdate {
+	update := permissionUpdate{Items: []permissionUpdateItem{}}
+	for _, item := range items {
+		if item.Inherited {
+			continue
+		}
+		update.Items = append(update.Items, permissionUpdateItem{
+			UserID:     item.UserID,
+			TeamID:     item.TeamID,
+			Role:       item.Role,
+			Permission: item.Permission,
+		})
+	}
+	return update
+}
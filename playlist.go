@@ -0,0 +1,29 @@
+package sdk
+
+// Types of playlist items.
+const (
+	PlaylistItemByUID = "dashboard_by_uid"
+	PlaylistItemByTag = "dashboard_by_tag"
+	// PlaylistItemByID is used by Grafana before v9.
+	PlaylistItemByID = "dashboard_by_id"
+)
+
+// Playlist is the list of dashboards shown one by one with the interval
+// like "5m".
+type Playlist struct {
+	ID       uint           `json:"id,omitempty"`
+	UID      string         `json:"uid,omitempty"`
+	Name     string         `json:"name"`
+	Interval string         `json:"interval"`
+	Items    []PlaylistItem `json:"items,omitempty"`
+}
+
+// PlaylistItem selects dashboards of the playlist. Value is the dashboard
+// uid or the tag depending on the type.
+type PlaylistItem struct {
+	ID    uint   `json:"id,omitempty"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	Order int    `json:"order"`
+	Title string `json:"title,omitempty"`
+}
@@ -19,8 +19,106 @@ package sdk
    ॐ तारे तुत्तारे तुरे स्व
 */
 
+import (
+	"encoding/json"
+	"sort"
+)
+
+// Preferences are the UI settings of the organization, team or user.
+// Empty fields mean the setting is inherited, in this order, from the
+// user, the teams of the user and the organization.
+//
+// Grafana accepts the cookie consent as Cookies and returns it as
+// CookiePreferences. When Cookies is not set the CookiePreferences are
+// sent as Cookies, so the loaded preferences could be saved back.
 type Preferences struct {
-	Theme           string `json:"theme,omitempty"`
-	HomeDashboardId uint   `json:"homeDashboardId,omitempty"`
-	Timezone        string `json:"timezone,omitempty"`
+	Theme             string                  `json:"theme,omitempty"`
+	HomeDashboardId   uint                    `json:"homeDashboardId,omitempty"`
+	HomeDashboardUID  string                  `json:"homeDashboardUID,omitempty"`
+	Timezone          string                  `json:"timezone,omitempty"`
+	WeekStart         string                  `json:"weekStart,omitempty"`
+	Locale            string                  `json:"locale,omitempty"`
+	QueryHistory      *QueryHistoryPreference `json:"queryHistory,omitempty"`
+	Navbar            *NavbarPreference       `json:"navbar,omitempty"`
+	Cookies           []string                `json:"cookies,omitempty"`
+	CookiePreferences CookiePreferences       `json:"cookiePreferences,omitempty"`
+}
+
+// MarshalJSON encodes the preferences as Grafana accepts them.
+func (p Preferences) MarshalJSON() ([]byte, error) {
+	type plain Preferences
+	out := plain(p)
+	if out.Cookies == nil {
+		out.Cookies = out.CookiePreferences
+	}
+	out.CookiePreferences = nil
+	return json.Marshal(out)
+}
+
+// PatchPreferences is a request to change the preferences partially.
+// Only the set fields are sent, the other preferences are kept.
+type PatchPreferences struct {
+	Theme            *string                 `json:"theme,omitempty"`
+	HomeDashboardId  *uint                   `json:"homeDashboardId,omitempty"`
+	HomeDashboardUID *string                 `json:"homeDashboardUID,omitempty"`
+	Timezone         *string                 `json:"timezone,omitempty"`
+	WeekStart        *string                 `json:"weekStart,omitempty"`
+	Locale           *string                 `json:"locale,omitempty"`
+	QueryHistory     *QueryHistoryPreference `json:"queryHistory,omitempty"`
+	Navbar           *NavbarPreference       `json:"navbar,omitempty"`
+	Cookies          *[]string               `json:"cookies,omitempty"`
+}
+
+// QueryHistoryPreference keeps settings of the query history drawer.
+// HomeTab is one of "query", "starred" or "settings".
+type QueryHistoryPreference struct {
+	HomeTab string `json:"homeTab"`
+}
+
+// NavbarPreference keeps the navigation bar items saved by the user.
+// Grafana v9 keeps them as SavedItems, later versions as BookmarkURLs.
+type NavbarPreference struct {
+	SavedItems   []NavbarItem `json:"savedItems,omitempty"`
+	BookmarkURLs []string     `json:"bookmarkUrls,omitempty"`
+}
+
+// NavbarItem is the link saved in the navigation bar.
+type NavbarItem struct {
+	ID     string `json:"id"`
+	Text   string `json:"text"`
+	URL    string `json:"url"`
+	Target string `json:"target,omitempty"`
+}
+
+// Types of cookies the user could consent to.
+const (
+	CookieAnalytics   = "analytics"
+	CookiePerformance = "performance"
+	CookieFunctional  = "functional"
+)
+
+// CookiePreferences lists the types of cookies the user consented to.
+// Grafana returns them as an object with the types as keys, the list
+// form is decoded too.
+type CookiePreferences []string
+
+// UnmarshalJSON decodes the cookie preferences from both forms.
+func (c *CookiePreferences) UnmarshalJSON(b []byte) error {
+	var (
+		list []string
+		set  map[string]json.RawMessage
+	)
+	if err := json.Unmarshal(b, &list); err == nil {
+		*c = list
+		return nil
+	}
+	if err := json.Unmarshal(b, &set); err != nil {
+		return err
+	}
+	*c = make(CookiePreferences, 0, len(set))
+	for name := range set {
+		*c = append(*c, name)
+	}
+	sort.Strings(*c)
+	return nil
 }
@@ -0,0 +1,39 @@
+package sdk
+
+import "time"
+
+// Share types of public dashboards.
+const (
+	PublicDashboardSharePublic = "public"
+	PublicDashboardShareEmail  = "email"
+)
+
+// PublicDashboard is the config of the dashboard shared publicly. The
+// dashboard is available without authorization by the access token,
+// see Client.PublicDashboardURL.
+type PublicDashboard struct {
+	UID                  string `json:"uid,omitempty"`
+	DashboardUID         string `json:"dashboardUid,omitempty"`
+	AccessToken          string `json:"accessToken,omitempty"`
+	IsEnabled            bool   `json:"isEnabled"`
+	TimeSelectionEnabled bool   `json:"timeSelectionEnabled"`
+	AnnotationsEnabled   bool   `json:"annotationsEnabled"`
+	// Share is one of PublicDashboardSharePublic or PublicDashboardShareEmail,
+	// Grafana uses PublicDashboardSharePublic when it is empty.
+	Share     string     `json:"share,omitempty"`
+	CreatedBy int        `json:"createdBy,omitempty"`
+	UpdatedBy int        `json:"updatedBy,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// FoundPublicDashboard is the public dashboard in the list of all public
+// dashboards of the organization.
+type FoundPublicDashboard struct {
+	UID          string `json:"uid"`
+	AccessToken  string `json:"accessToken"`
+	Title        string `json:"title"`
+	DashboardUID string `json:"dashboardUid"`
+	IsEnabled    bool   `json:"isEnabled"`
+	Slug         string `json:"slug,omitempty"`
+}
@@ -0,0 +1,111 @@
+package sdk
+
+import "encoding/json"
+
+// DatasourceQueryRequest is a request to query datasources. From and To
+// are either epoch milliseconds or relative time like "now-1h".
+type DatasourceQueryRequest struct {
+	From    string            `json:"from"`
+	To      string            `json:"to"`
+	Queries []DatasourceQuery `json:"queries"`
+}
+
+// DatasourceQuery is the query of the datasource. Besides refId,
+// datasource, intervalMs and maxDataPoints it keeps the fields specific
+// for the datasource type as they are in the panel targets.
+type DatasourceQuery map[string]interface{}
+
+// NewDatasourceQuery makes the query from the panel target for the
+// datasource. Only the fields modelled by Target are kept.
+func NewDatasourceQuery(t Target, ds Datasource) (DatasourceQuery, error) {
+	var (
+		target map[string]interface{}
+		raw    []byte
+		err    error
+	)
+	if raw, err = json.Marshal(t); err != nil {
+		return nil, err
+	}
+	if err = decodeJSON(raw, &target); err != nil {
+		return nil, err
+	}
+	return newDatasourceQuery(target, ds), nil
+}
+
+// newDatasourceQuery makes the query from the raw panel target keeping
+// all its fields.
+func newDatasourceQuery(target map[string]interface{}, ds Datasource) DatasourceQuery {
+	q := make(DatasourceQuery, len(target)+2)
+	for k, v := range target {
+		q[k] = v
+	}
+	q["datasourceId"] = ds.ID
+	q["datasource"] = map[string]string{"uid": ds.UID, "type": ds.Type}
+	return q
+}
+
+// DatasourceQueryResponse keeps results of the queries by their refId.
+type DatasourceQueryResponse struct {
+	Results map[string]QueryResult `json:"results"`
+}
+
+// QueryResult is the result of the single query.
+type QueryResult struct {
+	Error  string      `json:"error,omitempty"`
+	Status int         `json:"status,omitempty"`
+	Frames []DataFrame `json:"frames"`
+}
+
+// DataFrame is the data of the query result. It is encoded as panels
+// keep it in snapshotData and decoded both from this form and from the
+// schema and data form of the query API.
+type DataFrame struct {
+	Name   string           `json:"name,omitempty"`
+	RefID  string           `json:"refId,omitempty"`
+	Meta   json.RawMessage  `json:"meta,omitempty"`
+	Fields []DataFrameField `json:"fields"`
+}
+
+// DataFrameField is the column of the data frame.
+type DataFrameField struct {
+	Name   string            `json:"name"`
+	Type   string            `json:"type,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Config json.RawMessage   `json:"config,omitempty"`
+	Values []interface{}     `json:"values"`
+}
+
+// UnmarshalJSON decodes the data frame from both forms.
+func (f *DataFrame) UnmarshalJSON(b []byte) error {
+	var wire struct {
+		Schema *struct {
+			Name   string           `json:"name"`
+			RefID  string           `json:"refId"`
+			Meta   json.RawMessage  `json:"meta"`
+			Fields []DataFrameField `json:"fields"`
+		} `json:"schema"`
+		Data struct {
+			Values [][]interface{} `json:"values"`
+		} `json:"data"`
+	}
+	if err := decodeJSON(b, &wire); err != nil {
+		return err
+	}
+	if wire.Schema == nil {
+		type plain DataFrame
+		return decodeJSON(b, (*plain)(f))
+	}
+	f.Name = wire.Schema.Name
+	f.RefID = wire.Schema.RefID
+	f.Meta = wire.Schema.Meta
+	f.Fields = wire.Schema.Fields
+	for i := range f.Fields {
+		if i < len(wire.Data.Values) {
+			f.Fields[i].Values = wire.Data.Values[i]
+		}
+		if f.Fields[i].Values == nil {
+			f.Fields[i].Values = []interface{}{}
+		}
+	}
+	return nil
+}
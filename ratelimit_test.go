@@ -0,0 +1,45 @@
+package sdk_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bdunavant/sdk"
+)
+
+func TestRateLimiter_SharedByClients(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+	limiter := sdk.NewRateLimiter(50, 2)
+	clients := []*sdk.Client{
+		sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()), sdk.WithRateLimiter(limiter)),
+		sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()), sdk.WithRateLimiter(limiter)),
+	}
+	start := time.Now()
+	for i := 0; i < 7; i++ {
+		if _, err := clients[i%2].Search(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// two requests pass with the burst, other five wait for 20ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected the requests to be limited, they took %s", elapsed)
+	}
+}
+
+func TestRateLimiter_Context(t *testing.T) {
+	limiter := sdk.NewRateLimiter(0.1, 1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded error, got %v", err)
+	}
+}
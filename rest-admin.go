@@ -29,7 +29,7 @@ func (r *Client) CreateUser(ctx context.Context, user User) (StatusMessage, erro
 
 // UpdateUserPermissions updates the permissions of a global user.
 // Requires basic authentication and that the authenticated user is a Grafana Admin.
-// Reflects PUT /api/admin/users/:userId/password API call.
+// Reflects PUT /api/admin/users/:userId/permissions API call.
 func (r *Client) UpdateUserPermissions(ctx context.Context, permissions UserPermissions, uid uint) (StatusMessage, error) {
 	var (
 		raw   []byte
@@ -49,6 +49,8 @@ func (r *Client) UpdateUserPermissions(ctx context.Context, permissions UserPerm
 // SwitchUserContext switches user context to the given organization.
 // Requires basic authentication and that the authenticated user is a Grafana Admin.
 // Reflects POST /api/users/:userId/using/:organizationId API call.
+// Consider Client.WithOrg() for scoping requests to an organization without
+// changing the server side state.
 func (r *Client) SwitchUserContext(ctx context.Context, uid uint, oid uint) (StatusMessage, error) {
 	var (
 		raw  []byte
@@ -64,3 +66,145 @@ func (r *Client) SwitchUserContext(ctx context.Context, uid uint, oid uint) (Sta
 	}
 	return resp, nil
 }
+
+// GetSettings gets the settings of the Grafana server.
+// Requires that the authenticated user is a Grafana Admin.
+// Reflects GET /api/admin/settings API call.
+func (r *Client) GetSettings(ctx context.Context) (AdminSettings, error) {
+	var (
+		raw      []byte
+		settings AdminSettings
+		err      error
+	)
+	if raw, _, err = r.get(ctx, "api/admin/settings", nil); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, &settings)
+	return settings, err
+}
+
+// GetStats gets the usage statistics of the Grafana server.
+// Requires that the authenticated user is a Grafana Admin.
+// Reflects GET /api/admin/stats API call.
+func (r *Client) GetStats(ctx context.Context) (AdminStats, error) {
+	var (
+		raw   []byte
+		stats AdminStats
+		err   error
+	)
+	if raw, _, err = r.get(ctx, "api/admin/stats", nil); err != nil {
+		return stats, err
+	}
+	err = json.Unmarshal(raw, &stats)
+	return stats, err
+}
+
+// UpdateUserPassword sets the new password of the global user.
+// Requires basic authentication and that the authenticated user is a Grafana Admin.
+// Reflects PUT /api/admin/users/:userId/password API call.
+func (r *Client) UpdateUserPassword(ctx context.Context, uid uint, password string) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, err = json.Marshal(struct {
+		Password string `json:"password"`
+	}{password}); err != nil {
+		return StatusMessage{}, err
+	}
+	if raw, _, err = r.put(ctx, fmt.Sprintf("api/admin/users/%d/password", uid), nil, raw); err != nil {
+		return StatusMessage{}, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// DeleteUser deletes the global user.
+// Requires basic authentication and that the authenticated user is a Grafana Admin.
+// Reflects DELETE /api/admin/users/:userId API call.
+func (r *Client) DeleteUser(ctx context.Context, uid uint) (StatusMessage, error) {
+	return r.adminAction(ctx, "DELETE", fmt.Sprintf("api/admin/users/%d", uid))
+}
+
+// DisableUser disables the global user, the user can't log in and the
+// sessions of the user are revoked.
+// Requires basic authentication and that the authenticated user is a Grafana Admin.
+// Reflects POST /api/admin/users/:userId/disable API call.
+func (r *Client) DisableUser(ctx context.Context, uid uint) (StatusMessage, error) {
+	return r.adminAction(ctx, "POST", fmt.Sprintf("api/admin/users/%d/disable", uid))
+}
+
+// EnableUser enables the disabled global user.
+// Requires basic authentication and that the authenticated user is a Grafana Admin.
+// Reflects POST /api/admin/users/:userId/enable API call.
+func (r *Client) EnableUser(ctx context.Context, uid uint) (StatusMessage, error) {
+	return r.adminAction(ctx, "POST", fmt.Sprintf("api/admin/users/%d/enable", uid))
+}
+
+// GetUserAuthTokens gets the login sessions of the global user.
+// Requires basic authentication and that the authenticated user is a Grafana Admin.
+// Reflects GET /api/admin/users/:userId/auth-tokens API call.
+func (r *Client) GetUserAuthTokens(ctx context.Context, uid uint) ([]UserAuthToken, error) {
+	var (
+		raw    []byte
+		tokens []UserAuthToken
+		err    error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/admin/users/%d/auth-tokens", uid), nil); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, &tokens)
+	return tokens, err
+}
+
+// RevokeUserAuthToken revokes the login session of the global user.
+// Requires basic authentication and that the authenticated user is a Grafana Admin.
+// Reflects POST /api/admin/users/:userId/revoke-auth-token API call.
+func (r *Client) RevokeUserAuthToken(ctx context.Context, uid, tokenID uint) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, err = json.Marshal(struct {
+		AuthTokenID uint `json:"authTokenId"`
+	}{tokenID}); err != nil {
+		return StatusMessage{}, err
+	}
+	if raw, _, err = r.post(ctx, fmt.Sprintf("api/admin/users/%d/revoke-auth-token", uid), nil, raw); err != nil {
+		return StatusMessage{}, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// LogoutUser revokes all login sessions of the global user.
+// Requires basic authentication and that the authenticated user is a Grafana Admin.
+// Reflects POST /api/admin/users/:userId/logout API call.
+func (r *Client) LogoutUser(ctx context.Context, uid uint) (StatusMessage, error) {
+	return r.adminAction(ctx, "POST", fmt.Sprintf("api/admin/users/%d/logout", uid))
+}
+
+// ReloadProvisioning rereads the provisioning configuration of the
+// objects of the kind from the disk.
+// Requires that the authenticated user is a Grafana Admin.
+// Reflects POST /api/admin/provisioning/:kind/reload API call.
+func (r *Client) ReloadProvisioning(ctx context.Context, kind ProvisionedType) (StatusMessage, error) {
+	return r.adminAction(ctx, "POST", fmt.Sprintf("api/admin/provisioning/%s/reload", kind))
+}
+
+// adminAction sends the request without body and decodes the status
+// message of the reply.
+func (r *Client) adminAction(ctx context.Context, method, query string) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, _, err = r.doRequest(ctx, method, query, nil, nil); err != nil {
+		return StatusMessage{}, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
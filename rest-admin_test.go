@@ -0,0 +1,115 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestAdmin_SettingsAndStats(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	settings, err := client.GetSettings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.Get("security", "admin_user") != sdktest.AdminLogin || settings.Get("missing", "key") != "" {
+		t.Errorf("unexpected settings %+v", settings)
+	}
+	if _, err = client.CreateUser(ctx, sdk.User{Login: "viewer", Password: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	board := sdk.NewBoard("Stats")
+	board.Tags = []string{"a", "b"}
+	if _, err = client.SetDashboard(ctx, *board, sdk.SetDashboardParams{}); err != nil {
+		t.Fatal(err)
+	}
+	stats, err := client.GetStats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Orgs != 1 || stats.Users != 2 || stats.Admins != 1 || stats.Viewers != 1 ||
+		stats.Dashboards != 1 || stats.Tags != 2 || stats.ActiveSessions != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	for _, kind := range []sdk.ProvisionedType{
+		sdk.ProvisionedDashboards, sdk.ProvisionedDatasources, sdk.ProvisionedNotifications, sdk.ProvisionedPlugins,
+	} {
+		if _, err = client.ReloadProvisioning(ctx, kind); err != nil {
+			t.Errorf("reload %s: %v", kind, err)
+		}
+	}
+}
+
+func TestAdmin_UserLifecycle(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	st, err := client.CreateUser(ctx, sdk.User{Login: "leaver", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid := *st.ID
+	if _, err = client.UpdateUserPassword(ctx, uid, "changed"); err != nil {
+		t.Fatal(err)
+	}
+	leaver := sdk.NewClientWithOptions(srv.URL, sdk.WithBasicAuth("leaver", "changed"))
+	if _, err = leaver.GetActualUser(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	tokens, err := client.GetUserAuthTokens(ctx, uid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 1 || !tokens[0].IsActive || tokens[0].CreatedAt.IsZero() {
+		t.Fatalf("unexpected auth tokens %+v", tokens)
+	}
+	if _, err = client.RevokeUserAuthToken(ctx, uid, tokens[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.RevokeUserAuthToken(ctx, uid, tokens[0].ID); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if _, err = leaver.GetActualUser(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.LogoutUser(ctx, uid); err != nil {
+		t.Fatal(err)
+	}
+	if tokens, err = client.GetUserAuthTokens(ctx, uid); err != nil || len(tokens) != 0 {
+		t.Errorf("expected no auth tokens after logout, got %+v: %v", tokens, err)
+	}
+
+	if _, err = client.DisableUser(ctx, uid); err != nil {
+		t.Fatal(err)
+	}
+	if u, err := client.GetUser(ctx, uid); err != nil || !u.IsDisabled {
+		t.Errorf("expected disabled user, got %+v: %v", u, err)
+	}
+	if _, err = leaver.GetActualUser(ctx); !errors.Is(err, sdk.ErrUnauthorized) {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+	if _, err = client.EnableUser(ctx, uid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = leaver.GetActualUser(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = client.DeleteUser(ctx, uid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.GetUser(ctx, uid); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
@@ -22,21 +22,23 @@ import (
 	"fmt"
 )
 
+// Legacy alert notification channels were removed in Grafana v11,
+// the calls below return ErrUnsupported for it.
+
 // GetAllAlertNotifications gets all alert notification channels.
 // Reflects GET /api/alert-notifications API call.
 func (c *Client) GetAllAlertNotifications(ctx context.Context) ([]AlertNotification, error) {
+	if err := c.require(ctx, CapLegacyAlerting); err != nil {
+		return nil, err
+	}
 	var (
-		raw  []byte
-		an   []AlertNotification
-		code int
-		err  error
+		raw []byte
+		an  []AlertNotification
+		err error
 	)
-	if raw, code, err = c.get(ctx, "api/alert-notifications", nil); err != nil {
+	if raw, _, err = c.get(ctx, "api/alert-notifications", nil); err != nil {
 		return nil, err
 	}
-	if code != 200 {
-		return nil, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &an)
 	return an, err
 }
@@ -44,18 +46,17 @@ func (c *Client) GetAllAlertNotifications(ctx context.Context) ([]AlertNotificat
 // GetAlertNotificationUID gets the alert notification channel which has the specified uid.
 // Reflects GET /api/alert-notifications/uid/:uid API call.
 func (c *Client) GetAlertNotificationUID(ctx context.Context, uid string) (AlertNotification, error) {
+	if err := c.require(ctx, CapLegacyAlerting); err != nil {
+		return AlertNotification{}, err
+	}
 	var (
-		raw  []byte
-		an   AlertNotification
-		code int
-		err  error
+		raw []byte
+		an  AlertNotification
+		err error
 	)
-	if raw, code, err = c.get(ctx, fmt.Sprintf("api/alert-notifications/uid/%s", uid), nil); err != nil {
+	if raw, _, err = c.get(ctx, fmt.Sprintf("api/alert-notifications/uid/%s", uid), nil); err != nil {
 		return an, err
 	}
-	if code != 200 {
-		return an, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &an)
 	return an, err
 }
@@ -63,18 +64,17 @@ func (c *Client) GetAlertNotificationUID(ctx context.Context, uid string) (Alert
 // GetAlertNotificationID gets the alert notification channel which has the specified id.
 // Reflects GET /api/alert-notifications/:id API call.
 func (c *Client) GetAlertNotificationID(ctx context.Context, id uint) (AlertNotification, error) {
+	if err := c.require(ctx, CapLegacyAlerting); err != nil {
+		return AlertNotification{}, err
+	}
 	var (
-		raw  []byte
-		an   AlertNotification
-		code int
-		err  error
+		raw []byte
+		an  AlertNotification
+		err error
 	)
-	if raw, code, err = c.get(ctx, fmt.Sprintf("api/alert-notifications/%d", id), nil); err != nil {
+	if raw, _, err = c.get(ctx, fmt.Sprintf("api/alert-notifications/%d", id), nil); err != nil {
 		return an, err
 	}
-	if code != 200 {
-		return an, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &an)
 	return an, err
 }
@@ -82,20 +82,19 @@ func (c *Client) GetAlertNotificationID(ctx context.Context, id uint) (AlertNoti
 // CreateAlertNotification creates a new alert notification channel.
 // Reflects POST /api/alert-notifications API call.
 func (c *Client) CreateAlertNotification(ctx context.Context, an AlertNotification) (int64, error) {
+	if err := c.require(ctx, CapLegacyAlerting); err != nil {
+		return -1, err
+	}
 	var (
-		raw  []byte
-		code int
-		err  error
+		raw []byte
+		err error
 	)
 	if raw, err = json.Marshal(an); err != nil {
 		return -1, err
 	}
-	if raw, code, err = c.post(ctx, "api/alert-notifications", nil, raw); err != nil {
+	if raw, _, err = c.post(ctx, "api/alert-notifications", nil, raw); err != nil {
 		return -1, err
 	}
-	if code != 200 {
-		return -1, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	result := struct {
 		ID int64 `json:"id"`
 	}{}
@@ -106,56 +105,49 @@ func (c *Client) CreateAlertNotification(ctx context.Context, an AlertNotificati
 // UpdateAlertNotificationUID updates the specified alert notification channel.
 // Reflects PUT /api/alert-notifications/uid/:uid API call.
 func (c *Client) UpdateAlertNotificationUID(ctx context.Context, an AlertNotification, uid string) error {
+	if err := c.require(ctx, CapLegacyAlerting); err != nil {
+		return err
+	}
 	var (
-		raw  []byte
-		code int
-		err  error
+		raw []byte
+		err error
 	)
 	if raw, err = json.Marshal(an); err != nil {
 		return err
 	}
-	if raw, code, err = c.put(ctx, fmt.Sprintf("api/alert-notifications/uid/%s", uid), nil, raw); err != nil {
+	if raw, _, err = c.put(ctx, fmt.Sprintf("api/alert-notifications/uid/%s", uid), nil, raw); err != nil {
 		return err
 	}
-	if code != 200 {
-		return fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	return nil
 }
 
 // UpdateAlertNotificationID updates the specified alert notification channel.
 // Reflects PUT /api/alert-notifications/:id API call.
 func (c *Client) UpdateAlertNotificationID(ctx context.Context, an AlertNotification, id uint) error {
+	if err := c.require(ctx, CapLegacyAlerting); err != nil {
+		return err
+	}
 	var (
-		raw  []byte
-		code int
-		err  error
+		raw []byte
+		err error
 	)
 	if raw, err = json.Marshal(an); err != nil {
 		return err
 	}
-	if raw, code, err = c.put(ctx, fmt.Sprintf("api/alert-notifications/%d", id), nil, raw); err != nil {
+	if raw, _, err = c.put(ctx, fmt.Sprintf("api/alert-notifications/%d", id), nil, raw); err != nil {
 		return err
 	}
-	if code != 200 {
-		return fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	return nil
 }
 
 // DeleteAlertNotificationUID deletes the specified alert notification channel.
 // Reflects DELETE /api/alert-notifications/uid/:uid API call.
 func (c *Client) DeleteAlertNotificationUID(ctx context.Context, uid string) error {
-	var (
-		raw  []byte
-		code int
-		err  error
-	)
-	if raw, code, err = c.delete(ctx, fmt.Sprintf("api/alert-notifications/uid/%s", uid)); err != nil {
+	if err := c.require(ctx, CapLegacyAlerting); err != nil {
 		return err
 	}
-	if code != 200 {
-		return fmt.Errorf("HTTP error %d: returns %s", code, raw)
+	if _, _, err := c.delete(ctx, fmt.Sprintf("api/alert-notifications/uid/%s", uid)); err != nil {
+		return err
 	}
 	return nil
 }
@@ -163,16 +155,11 @@ func (c *Client) DeleteAlertNotificationUID(ctx context.Context, uid string) err
 // DeleteAlertNotificationID deletes the specified alert notification channel.
 // Reflects DELETE /api/alert-notifications/:id API call.
 func (c *Client) DeleteAlertNotificationID(ctx context.Context, id uint) error {
-	var (
-		raw  []byte
-		code int
-		err  error
-	)
-	if raw, code, err = c.delete(ctx, fmt.Sprintf("api/alert-notifications/%d", id)); err != nil {
+	if err := c.require(ctx, CapLegacyAlerting); err != nil {
 		return err
 	}
-	if code != 200 {
-		return fmt.Errorf("HTTP error %d: returns %s", code, raw)
+	if _, _, err := c.delete(ctx, fmt.Sprintf("api/alert-notifications/%d", id)); err != nil {
+		return err
 	}
 	return nil
 }
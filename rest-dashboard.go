@@ -74,7 +74,11 @@ func (r *Client) GetDashboardByUID(ctx context.Context, uid string) (Board, Boar
 //
 // Reflects GET /api/dashboards/db/:slug API call.
 // Deprecated: since Grafana v5 you should use uids. Use GetDashboardByUID() for that.
+// Since Grafana v8 the call returns ErrUnsupported.
 func (r *Client) GetDashboardBySlug(ctx context.Context, slug string) (Board, BoardProperties, error) {
+	if err := r.require(ctx, CapDashboardSlugAPI); err != nil {
+		return Board{}, BoardProperties{}, err
+	}
 	path := setPrefix(slug)
 	return r.getDashboard(ctx, path)
 }
@@ -124,15 +128,11 @@ func (r *Client) getRawDashboard(ctx context.Context, path string) ([]byte, Boar
 			Meta  BoardProperties `json:"meta"`
 			Board json.RawMessage `json:"dashboard"`
 		}
-		code int
-		err  error
+		err error
 	)
-	if raw, code, err = r.get(ctx, fmt.Sprintf("api/dashboards/%s", path), nil); err != nil {
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/dashboards/%s", path), nil); err != nil {
 		return nil, BoardProperties{}, err
 	}
-	if code != 200 {
-		return nil, BoardProperties{}, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&result); err != nil {
@@ -156,11 +156,107 @@ func (r *Client) GetRawDashboardByUID(ctx context.Context, uid string) ([]byte,
 //
 // Reflects GET /api/dashboards/db/:slug API call.
 // Deprecated: since Grafana v5 you should use uids. Use GetRawDashboardByUID() for that.
+// Since Grafana v8 the call returns ErrUnsupported.
 func (r *Client) GetRawDashboardBySlug(ctx context.Context, slug string) ([]byte, BoardProperties, error) {
+	if err := r.require(ctx, CapDashboardSlugAPI); err != nil {
+		return nil, BoardProperties{}, err
+	}
 	path := setPrefix(slug)
 	return r.getRawDashboard(ctx, path)
 }
 
+// HomeDashboard is the home dashboard of the current user. When the home
+// dashboard is chosen in the preferences Grafana answers with the redirect
+// to it instead of the dashboard itself.
+type HomeDashboard struct {
+	Board Board
+	Meta  BoardProperties
+	// RedirectURI is the path of the chosen home dashboard like
+	// "/d/uid/slug" or "/grafana/d/uid/slug" when Grafana is served
+	// from the subpath, it is empty for the default home dashboard.
+	RedirectURI string
+	// RedirectUID is the uid of the chosen home dashboard taken from
+	// the RedirectURI.
+	RedirectUID string
+}
+
+// GetHomeDashboard loads the home dashboard of the current user.
+// Use GetDashboardByUID with RedirectUID for loading the chosen home
+// dashboard.
+//
+// Reflects GET /api/dashboards/home API call.
+func (r *Client) GetHomeDashboard(ctx context.Context) (HomeDashboard, error) {
+	var (
+		raw    []byte
+		result struct {
+			Meta        BoardProperties `json:"meta"`
+			Board       json.RawMessage `json:"dashboard"`
+			RedirectURI string          `json:"redirectUri"`
+		}
+		home HomeDashboard
+		err  error
+	)
+	if raw, _, err = r.get(ctx, "api/dashboards/home", nil); err != nil {
+		return home, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err = dec.Decode(&result); err != nil {
+		return home, errors.Wrap(err, "unmarshal home dashboard")
+	}
+	home.Meta = result.Meta
+	home.RedirectURI = result.RedirectURI
+	if result.RedirectURI != "" {
+		home.RedirectUID = redirectUID(result.RedirectURI)
+		return home, nil
+	}
+	dec = json.NewDecoder(bytes.NewReader(result.Board))
+	dec.UseNumber()
+	if err = dec.Decode(&home.Board); err != nil {
+		return home, errors.Wrap(err, "unmarshal board")
+	}
+	return home, nil
+}
+
+// redirectUID returns the dashboard uid from the path like "/d/uid/slug".
+// Grafana served from the subpath prefixes it, for example
+// "/grafana/d/uid/slug", so the "d" segment is searched for.
+func redirectUID(uri string) string {
+	if i := strings.IndexAny(uri, "?#"); i >= 0 {
+		uri = uri[:i]
+	}
+	parts := strings.Split(uri, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "d" && parts[i+1] != "" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// DashboardTag is the tag of dashboards with the number of dashboards
+// tagged with it.
+type DashboardTag struct {
+	Term  string `json:"term"`
+	Count int    `json:"count"`
+}
+
+// GetDashboardTags returns all tags of dashboards with their counts.
+//
+// Reflects GET /api/dashboards/tags API call.
+func (r *Client) GetDashboardTags(ctx context.Context) ([]DashboardTag, error) {
+	var (
+		raw  []byte
+		tags []DashboardTag
+		err  error
+	)
+	if raw, _, err = r.get(ctx, "api/dashboards/tags", nil); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, &tags)
+	return tags, err
+}
+
 // FoundBoard keeps result of search with metadata of a dashboard.
 type FoundBoard struct {
 	ID          uint     `json:"id"`
@@ -203,7 +299,6 @@ func (r *Client) Search(ctx context.Context, params ...SearchParam) ([]FoundBoar
 	var (
 		raw    []byte
 		boards []FoundBoard
-		code   int
 		err    error
 	)
 	u := url.URL{}
@@ -211,12 +306,9 @@ func (r *Client) Search(ctx context.Context, params ...SearchParam) ([]FoundBoar
 	for _, p := range params {
 		p(&q)
 	}
-	if raw, code, err = r.get(ctx, "api/search", q); err != nil {
+	if raw, _, err = r.get(ctx, "api/search", q); err != nil {
 		return nil, err
 	}
-	if code != 200 {
-		return nil, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &boards)
 	return boards, err
 }
@@ -224,51 +316,75 @@ func (r *Client) Search(ctx context.Context, params ...SearchParam) ([]FoundBoar
 // SetDashboardParams contains the extra parameteres
 // that affects where and how the dashboard will be stored
 type SetDashboardParams struct {
-	FolderID  int
+	FolderID int
+	// FolderUID takes precedence over FolderID when set.
+	FolderUID string
 	Overwrite bool
+	// Message is the commit message stored in the dashboard version history.
+	Message string
+	// CheckVersion saves the dashboard only if its version on the server
+	// is still the board Version. The board ID and Version are sent as is
+	// and Grafana rejects the save with ErrVersionMismatch or ErrNameExists
+	// instead of overwriting. It can't be used together with Overwrite.
+	CheckVersion bool
+}
+
+// SetDashboardResponse is the result of the dashboard saving.
+type SetDashboardResponse struct {
+	ID      uint   `json:"id"`
+	UID     string `json:"uid"`
+	URL     string `json:"url"`
+	Slug    string `json:"slug"`
+	Version int    `json:"version"`
+	Status  string `json:"status"`
 }
 
 // SetDashboard updates existing dashboard or creates a new one.
 // Set dasboard ID to nil to create a new dashboard.
 // Set overwrite to true if you want to overwrite existing dashboard with
 // newer version or with same dashboard title.
+// Without overwrite and version check the dashboard ID is reset so the
+// dashboard is matched by its UID only.
 // Grafana only can create or update a dashboard in a database. File dashboards
 // may be only loaded with HTTP API but not created or updated.
 //
 // Reflects POST /api/dashboards/db API call.
-func (r *Client) SetDashboard(ctx context.Context, board Board, params SetDashboardParams) (StatusMessage, error) {
+func (r *Client) SetDashboard(ctx context.Context, board Board, params SetDashboardParams) (SetDashboardResponse, error) {
 	var (
 		isBoardFromDB bool
 		newBoard      struct {
-			Dashboard Board `json:"dashboard"`
-			FolderID  int   `json:"folderId"`
-			Overwrite bool  `json:"overwrite"`
+			Dashboard Board  `json:"dashboard"`
+			FolderID  int    `json:"folderId"`
+			FolderUID string `json:"folderUid,omitempty"`
+			Overwrite bool   `json:"overwrite"`
+			Message   string `json:"message,omitempty"`
 		}
 		raw  []byte
-		resp StatusMessage
-		code int
+		resp SetDashboardResponse
 		err  error
 	)
 	if board.Slug, isBoardFromDB = cleanPrefix(board.Slug); !isBoardFromDB {
-		return StatusMessage{}, errors.New("only database dashboard (with 'db/' prefix in a slug) can be set")
+		return SetDashboardResponse{}, errors.New("only database dashboard (with 'db/' prefix in a slug) can be set")
+	}
+	if params.CheckVersion && params.Overwrite {
+		return SetDashboardResponse{}, errors.New("overwrite can't be used with the version check")
 	}
 	newBoard.Dashboard = board
 	newBoard.FolderID = params.FolderID
+	newBoard.FolderUID = params.FolderUID
 	newBoard.Overwrite = params.Overwrite
-	if !params.Overwrite {
+	newBoard.Message = params.Message
+	if !params.Overwrite && !params.CheckVersion {
 		newBoard.Dashboard.ID = 0
 	}
 	if raw, err = json.Marshal(newBoard); err != nil {
-		return StatusMessage{}, err
+		return SetDashboardResponse{}, err
 	}
-	if raw, code, err = r.post(ctx, "api/dashboards/db", nil, raw); err != nil {
-		return StatusMessage{}, err
+	if raw, _, err = r.post(ctx, "api/dashboards/db", nil, raw); err != nil {
+		return SetDashboardResponse{}, err
 	}
 	if err = json.Unmarshal(raw, &resp); err != nil {
-		return StatusMessage{}, err
-	}
-	if code != 200 {
-		return resp, fmt.Errorf("HTTP error %d: returns %s", code, *resp.Message)
+		return SetDashboardResponse{}, err
 	}
 	return resp, nil
 }
@@ -283,7 +399,6 @@ func (r *Client) SetRawDashboard(ctx context.Context, raw []byte) (StatusMessage
 	var (
 		rawResp []byte
 		resp    StatusMessage
-		code    int
 		err     error
 		buf     bytes.Buffer
 		plain   = make(map[string]interface{})
@@ -297,15 +412,12 @@ func (r *Client) SetRawDashboard(ctx context.Context, raw []byte) (StatusMessage
 	buf.WriteString(`{"dashboard":`)
 	buf.Write(raw)
 	buf.WriteString(`, "overwrite": true}`)
-	if rawResp, code, err = r.post(ctx, "api/dashboards/db", nil, buf.Bytes()); err != nil {
+	if rawResp, _, err = r.post(ctx, "api/dashboards/db", nil, buf.Bytes()); err != nil {
 		return StatusMessage{}, err
 	}
 	if err = json.Unmarshal(rawResp, &resp); err != nil {
 		return StatusMessage{}, err
 	}
-	if code != 200 {
-		return StatusMessage{}, fmt.Errorf("HTTP error %d: returns %s", code, *resp.Message)
-	}
 	return resp, nil
 }
 
@@ -314,6 +426,7 @@ func (r *Client) SetRawDashboard(ctx context.Context, raw []byte) (StatusMessage
 // may be only loaded with HTTP API but not deteled.
 //
 // Reflects DELETE /api/dashboards/db/:slug API call.
+// Since Grafana v8 the call returns ErrUnsupported, use DeleteDashboardByUID().
 func (r *Client) DeleteDashboard(ctx context.Context, slug string) (StatusMessage, error) {
 	var (
 		isBoardFromDB bool
@@ -321,6 +434,9 @@ func (r *Client) DeleteDashboard(ctx context.Context, slug string) (StatusMessag
 		reply         StatusMessage
 		err           error
 	)
+	if err = r.require(ctx, CapDashboardSlugAPI); err != nil {
+		return StatusMessage{}, err
+	}
 	if slug, isBoardFromDB = cleanPrefix(slug); !isBoardFromDB {
 		return StatusMessage{}, errors.New("only database dashboards (with 'db/' prefix in a slug) can be removed")
 	}
@@ -397,6 +513,14 @@ func SearchDashboardID(dashboardID int) SearchParam {
 	}
 }
 
+// SearchDashboardUID specifies Search dashboard uid's to search for.
+// Can be specified multiple times, logical OR is applied.
+func SearchDashboardUID(uid string) SearchParam {
+	return func(v *url.Values) {
+		v.Add("dashboardUIDs", uid)
+	}
+}
+
 // SearchFolderID specifies Search folder id's to search for.
 // Can be specified multiple times, logical OR is applied.
 func SearchFolderID(folderID int) SearchParam {
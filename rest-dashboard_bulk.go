@@ -0,0 +1,84 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// DashboardResult is the result of fetching one dashboard with
+// GetDashboardsByUIDs.
+type DashboardResult struct {
+	// Index is the position of UID in the requested list.
+	Index int
+	UID   string
+	// Raw is the dashboard JSON as it returned by Grafana.
+	Raw  []byte
+	Meta BoardProperties
+	// Err is set when the dashboard failed to load, other fields
+	// except Index and UID are empty then.
+	Err error
+}
+
+// Board unmarshals the raw dashboard.
+func (d DashboardResult) Board() (Board, error) {
+	var board Board
+	if d.Err != nil {
+		return board, d.Err
+	}
+	dec := json.NewDecoder(bytes.NewReader(d.Raw))
+	dec.UseNumber()
+	err := dec.Decode(&board)
+	return board, err
+}
+
+// GetDashboardsByUIDs loads dashboards by uids with the number of parallel
+// requests limited by concurrency. Results are sent to the returned channel
+// in order of completion, each uid gets exactly one result with its
+// position in uids. The channel is closed after the last result.
+//
+// Requests go through the rate limiter and the retry policy of the client.
+// When ctx is cancelled the rest of dashboards are not requested and their
+// results may be dropped. The caller must either drain the channel or
+// cancel ctx to release the workers.
+//
+// It uses GET /api/dashboards/uid/:uid API call.
+func (r *Client) GetDashboardsByUIDs(ctx context.Context, uids []string, concurrency int) <-chan DashboardResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > len(uids) {
+		concurrency = len(uids)
+	}
+	var (
+		jobs    = make(chan int)
+		results = make(chan DashboardResult, concurrency)
+		wg      sync.WaitGroup
+	)
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				res := DashboardResult{Index: idx, UID: uids[idx]}
+				if res.Err = ctx.Err(); res.Err == nil {
+					res.Raw, res.Meta, res.Err = r.GetRawDashboardByUID(ctx, uids[idx])
+				}
+				select {
+				case results <- res:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+	go func() {
+		for idx := range uids {
+			jobs <- idx
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
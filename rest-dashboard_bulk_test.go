@@ -0,0 +1,97 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestGetDashboardsByUIDs(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	var uids []string
+	for i := 0; i < 10; i++ {
+		uid := fmt.Sprintf("board-%d", i)
+		raw := fmt.Sprintf(`{"uid":%q,"title":"Board %d"}`, uid, i)
+		if _, err := srv.Client.SetRawDashboard(ctx, []byte(raw)); err != nil {
+			t.Fatal(err)
+		}
+		uids = append(uids, uid)
+	}
+	uids = append(uids, "missing")
+
+	seen := make(map[int]bool)
+	for res := range srv.Client.GetDashboardsByUIDs(ctx, uids, 3) {
+		if seen[res.Index] || uids[res.Index] != res.UID {
+			t.Errorf("unexpected result %d for %s", res.Index, res.UID)
+		}
+		seen[res.Index] = true
+		if res.UID == "missing" {
+			if !errors.Is(res.Err, sdk.ErrNotFound) {
+				t.Errorf("expected not found error, got %v", res.Err)
+			}
+			continue
+		}
+		board, err := res.Board()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if board.UID != res.UID || res.Meta.Version != 1 {
+			t.Errorf("unexpected dashboard %s with meta %+v for %s", board.UID, res.Meta, res.UID)
+		}
+	}
+	if len(seen) != len(uids) {
+		t.Errorf("expected %d results, got %d", len(uids), len(seen))
+	}
+}
+
+func TestGetDashboardsByUIDs_Concurrency(t *testing.T) {
+	var (
+		mu               sync.Mutex
+		inflight, maxObs int
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inflight++
+		if inflight > maxObs {
+			maxObs = inflight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inflight--
+		mu.Unlock()
+		uid := strings.TrimPrefix(r.URL.Path, "/api/dashboards/uid/")
+		fmt.Fprintf(w, `{"meta":{"slug":%q},"dashboard":{"uid":%q}}`, uid, uid)
+	}))
+	defer ts.Close()
+
+	client := sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()))
+	uids := make([]string, 12)
+	for i := range uids {
+		uids[i] = fmt.Sprint(i)
+	}
+	var n int
+	for res := range client.GetDashboardsByUIDs(context.Background(), uids, 4) {
+		if res.Err != nil {
+			t.Fatal(res.Err)
+		}
+		n++
+	}
+	if n != len(uids) {
+		t.Errorf("expected %d results, got %d", len(uids), n)
+	}
+	if maxObs > 4 || maxObs < 2 {
+		t.Errorf("expected up to 4 parallel requests, observed %d", maxObs)
+	}
+}
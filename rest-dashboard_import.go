@@ -0,0 +1,63 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// ImportDashboardRequest is a request to import the dashboard exported
+// for sharing externally. Dashboard is the raw JSON with __inputs.
+type ImportDashboardRequest struct {
+	Dashboard json.RawMessage        `json:"dashboard"`
+	Overwrite bool                   `json:"overwrite"`
+	Inputs    []ImportDashboardInput `json:"inputs"`
+	FolderID  int                    `json:"folderId,omitempty"`
+	FolderUID string                 `json:"folderUid,omitempty"`
+}
+
+// ImportDashboardResponse is the result of the dashboard import.
+type ImportDashboardResponse struct {
+	UID              string `json:"uid"`
+	PluginID         string `json:"pluginId"`
+	Title            string `json:"title"`
+	Imported         bool   `json:"imported"`
+	ImportedURI      string `json:"importedUri"`
+	ImportedURL      string `json:"importedUrl"`
+	Slug             string `json:"slug"`
+	DashboardID      uint   `json:"dashboardId"`
+	FolderID         int    `json:"folderId"`
+	FolderUID        string `json:"folderUid,omitempty"`
+	ImportedRevision int    `json:"importedRevision"`
+	Revision         int    `json:"revision"`
+	Description      string `json:"description"`
+	Path             string `json:"path"`
+	Removed          bool   `json:"removed"`
+}
+
+// ImportDashboard imports the dashboard substituting its inputs with
+// the values on the server side. The inputs are validated before the
+// request, see ValidateDashboardInputs. Use NewImportInputs for making
+// the inputs from the declared ones.
+//
+// Reflects POST /api/dashboards/import API call.
+func (r *Client) ImportDashboard(ctx context.Context, req ImportDashboardRequest) (ImportDashboardResponse, error) {
+	var (
+		raw  []byte
+		resp ImportDashboardResponse
+		err  error
+	)
+	if err = ValidateDashboardInputs(req.Dashboard, req.Inputs); err != nil {
+		return resp, err
+	}
+	if req.Inputs == nil {
+		req.Inputs = []ImportDashboardInput{}
+	}
+	if raw, err = json.Marshal(req); err != nil {
+		return resp, err
+	}
+	if raw, _, err = r.post(ctx, "api/dashboards/import", nil, raw); err != nil {
+		return resp, err
+	}
+	err = json.Unmarshal(raw, &resp)
+	return resp, err
+}
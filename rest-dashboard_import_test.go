@@ -0,0 +1,99 @@
+package sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+const sharedDashboard = `{
+  "__inputs": [
+    {"name": "DS_PROMETHEUS", "label": "Prometheus", "type": "datasource", "pluginId": "prometheus", "pluginName": "Prometheus"},
+    {"name": "VAR_ENV", "label": "env", "type": "constant", "value": "prod"}
+  ],
+  "__requires": [{"type": "datasource", "id": "prometheus", "name": "Prometheus", "version": "1.0.0"}],
+  "id": null,
+  "uid": "shared",
+  "title": "Shared",
+  "panels": [
+    {"id": 1, "datasource": {"type": "prometheus", "uid": "${DS_PROMETHEUS}"}, "targets": [{"expr": "up{env=\"${VAR_ENV}\"}"}]}
+  ]
+}`
+
+func TestResolveDashboardInputs(t *testing.T) {
+	if _, err := sdk.NewImportInputs([]byte(sharedDashboard), nil); !errors.Is(err, sdk.ErrMissingInputs) {
+		t.Fatalf("expected missing inputs error, got %v", err)
+	}
+	inputs, err := sdk.NewImportInputs([]byte(sharedDashboard), map[string]string{"DS_PROMETHEUS": "prom-uid"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) != 2 || inputs[0].PluginID != "prometheus" || inputs[1].Value != "prod" {
+		t.Errorf("unexpected inputs %+v", inputs)
+	}
+	raw, err := sdk.ResolveDashboardInputs([]byte(sharedDashboard), inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var board map[string]interface{}
+	if err = json.Unmarshal(raw, &board); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := board["__inputs"]; ok {
+		t.Error("expected __inputs removed")
+	}
+	panel := board["panels"].([]interface{})[0].(map[string]interface{})
+	if uid := panel["datasource"].(map[string]interface{})["uid"]; uid != "prom-uid" {
+		t.Errorf("unexpected datasource uid %v", uid)
+	}
+	if expr := panel["targets"].([]interface{})[0].(map[string]interface{})["expr"]; expr != `up{env="prod"}` {
+		t.Errorf("unexpected target %v", expr)
+	}
+}
+
+func TestImportDashboard(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	req := sdk.ImportDashboardRequest{Dashboard: json.RawMessage(sharedDashboard), Overwrite: true}
+	if _, err := client.ImportDashboard(ctx, req); !errors.Is(err, sdk.ErrMissingInputs) {
+		t.Fatalf("expected missing inputs error, got %v", err)
+	}
+	inputs, err := sdk.NewImportInputs(req.Dashboard, map[string]string{"DS_PROMETHEUS": "prom-uid", "VAR_ENV": "stage"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Inputs = inputs
+	resp, err := client.ImportDashboard(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Imported || resp.UID != "shared" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+	raw, _, err := client.GetRawDashboardByUID(ctx, "shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var board struct {
+		Inputs []sdk.DashboardInput `json:"__inputs"`
+		Panels []struct {
+			Datasource struct {
+				UID string `json:"uid"`
+			} `json:"datasource"`
+			Targets []sdk.Target `json:"targets"`
+		} `json:"panels"`
+	}
+	if err = json.Unmarshal(raw, &board); err != nil {
+		t.Fatal(err)
+	}
+	if board.Inputs != nil || len(board.Panels) != 1 || board.Panels[0].Datasource.UID != "prom-uid" || board.Panels[0].Targets[0].Expr != `up{env="stage"}` {
+		t.Errorf("unexpected imported dashboard %s", raw)
+	}
+}
@@ -3,6 +3,7 @@ package sdk_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -27,7 +28,7 @@ func Test_Dashboard_CRUD(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if _, err = client.DeleteDashboard(ctx, board.UpdateSlug()); err != nil {
+	if _, err = client.DeleteDashboard(ctx, board.UpdateSlug()); err != nil && !errors.Is(err, sdk.ErrNotFound) {
 		t.Fatal(err)
 	}
 
@@ -79,7 +80,7 @@ func Test_Dashboard_CRUD_By_UID(t *testing.T) {
 	}
 
 	//Cleanup if Already exists
-	if _, err = client.DeleteDashboardByUID(ctx, board.UID); err != nil {
+	if _, err = client.DeleteDashboardByUID(ctx, board.UID); err != nil && !errors.Is(err, sdk.ErrNotFound) {
 		t.Fatal(err)
 	}
 
@@ -105,7 +106,7 @@ func Test_Dashboard_CRUD_By_UID(t *testing.T) {
 	}
 
 	//Verify that it has been deleted
-	if boardResult, _, err = client.GetDashboardByUID(ctx, board.UID); err == nil {
+	if boardResult, _, err = client.GetDashboardByUID(ctx, board.UID); !errors.Is(err, sdk.ErrNotFound) {
 		t.Fatal("Failed to delete dashboard, it can still be retrieved")
 	}
 
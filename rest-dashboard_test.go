@@ -2,6 +2,7 @@ package sdk_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -10,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
 )
 
 func TestClient_SearchDashboards(t *testing.T) {
@@ -156,6 +158,10 @@ func TestSearchDashboardID(t *testing.T) {
 	testRepeatableIntSearchParam(t, sdk.SearchDashboardID, "dashboardIds", []int{100, 200})
 }
 
+func TestSearchDashboardUID(t *testing.T) {
+	testRepeatableStringSearchParam(t, sdk.SearchDashboardUID, "dashboardUIDs", []string{"api", "db"})
+}
+
 func TestSearchFolderID(t *testing.T) {
 	testRepeatableIntSearchParam(t, sdk.SearchFolderID, "folderIds", []int{100, 200})
 }
@@ -283,3 +289,152 @@ func testUIntSearchParam(t *testing.T, sp func(uint) sdk.SearchParam, key string
 		}
 	}
 }
+
+func TestClient_SetDashboard_CheckVersion(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	folder, err := client.CreateFolder(ctx, sdk.Folder{Title: "Shared"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	board := sdk.NewBoard("Latency")
+	board.ID = 0
+	board.UID = "latency"
+	params := sdk.SetDashboardParams{FolderUID: folder.UID, Message: "initial", CheckVersion: true}
+	resp, err := client.SetDashboard(ctx, *board, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.UID != "latency" || resp.Version != 1 || resp.Status != "success" || resp.URL == "" {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	board.ID, board.Version = resp.ID, uint(resp.Version)
+
+	// a colleague saves the dashboard in the meantime
+	theirs := *board
+	theirs.Tags = []string{"edited"}
+	if _, err = client.SetDashboard(ctx, theirs, params); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.SetDashboard(ctx, *board, params); !errors.Is(err, sdk.ErrVersionMismatch) {
+		t.Fatalf("expected version mismatch, got %v", err)
+	}
+	if errors.Is(err, sdk.ErrNameExists) {
+		t.Errorf("version mismatch matches name exists: %v", err)
+	}
+
+	other := sdk.NewBoard("Latency")
+	other.ID = 0
+	if _, err = client.SetDashboard(ctx, *other, params); !errors.Is(err, sdk.ErrNameExists) {
+		t.Fatalf("expected name exists, got %v", err)
+	}
+	if _, err = client.SetDashboard(ctx, *board, sdk.SetDashboardParams{Overwrite: true, CheckVersion: true}); err == nil {
+		t.Error("expected error for overwrite with version check")
+	}
+
+	_, meta, err := client.GetDashboardByUID(ctx, "latency")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.Version != 2 || meta.FolderID != folder.ID {
+		t.Errorf("unexpected meta %+v", meta)
+	}
+	versions, err := client.GetDashboardVersions(ctx, "latency", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 2 || versions[len(versions)-1].Message != "initial" {
+		t.Errorf("unexpected versions %+v", versions)
+	}
+}
+
+func TestClient_StarsHomeAndTags(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	home, err := client.GetHomeDashboard(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !home.Meta.IsHome || home.Board.Title != "Home" || home.RedirectURI != "" {
+		t.Errorf("unexpected default home dashboard %+v", home)
+	}
+
+	for _, raw := range []string{
+		`{"uid":"api","title":"API","tags":["prod","http"]}`,
+		`{"uid":"db","title":"DB","tags":["prod"]}`,
+	} {
+		if _, err = client.SetRawDashboard(ctx, []byte(raw)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tags, err := client.GetDashboardTags(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tags, []sdk.DashboardTag{{Term: "http", Count: 1}, {Term: "prod", Count: 2}}) {
+		t.Errorf("unexpected tags %+v", tags)
+	}
+
+	found, err := client.Search(ctx, sdk.SearchQuery("API"))
+	if err != nil || len(found) != 1 {
+		t.Fatalf("unexpected search result %+v: %v", found, err)
+	}
+	if _, err = client.StarDashboard(ctx, found[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if found, err = client.Search(ctx, sdk.SearchStarred(true)); err != nil || len(found) != 1 || !found[0].IsStarred {
+		t.Fatalf("unexpected starred dashboards %+v: %v", found, err)
+	}
+	if _, err = client.UnstarDashboard(ctx, found[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, meta, err := client.GetDashboardByUID(ctx, "api"); err != nil || meta.IsStarred {
+		t.Errorf("expected unstarred dashboard, got %+v: %v", meta, err)
+	}
+	if _, err = client.StarDashboardByUID(ctx, "db"); err != nil {
+		t.Fatal(err)
+	}
+	if _, meta, err := client.GetDashboardByUID(ctx, "db"); err != nil || !meta.IsStarred {
+		t.Errorf("expected dashboard starred by uid, got %+v: %v", meta, err)
+	}
+	if _, err = client.UnstarDashboardByUID(ctx, "db"); err != nil {
+		t.Fatal(err)
+	}
+	if _, meta, err := client.GetDashboardByUID(ctx, "db"); err != nil || meta.IsStarred {
+		t.Errorf("expected dashboard unstarred by uid, got %+v: %v", meta, err)
+	}
+	if _, err = client.StarDashboardByUID(ctx, "missing"); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	if _, err = client.UpdateActualOrgPreferences(ctx, sdk.Preferences{HomeDashboardId: found[0].ID}); err != nil {
+		t.Fatal(err)
+	}
+	if home, err = client.GetHomeDashboard(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if home.RedirectUID != "api" {
+		t.Errorf("unexpected home redirect %+v", home)
+	}
+}
+
+func TestGetHomeDashboard_Subpath(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"meta":{"isHome":true},"redirectUri":"/grafana/d/ops-home/operations?orgId=1"}`))
+	}))
+	defer ts.Close()
+	client := sdk.NewClientWithOptions(ts.URL+"/grafana", sdk.WithHTTPClient(ts.Client()))
+	home, err := client.GetHomeDashboard(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if home.RedirectUID != "ops-home" {
+		t.Errorf("unexpected home redirect %+v", home)
+	}
+}
@@ -0,0 +1,137 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// GetDashboardVersions gets metadata of the saved versions of the dashboard
+// from the newest to the oldest. Limit restricts the number of versions
+// and start skips the newest ones for paging, zero values mean defaults
+// of Grafana. Grafana 11 pages the versions with the continue token
+// instead, use GetDashboardVersionsPage to follow it.
+//
+// Reflects GET /api/dashboards/uid/:uid/versions API call.
+func (r *Client) GetDashboardVersions(ctx context.Context, uid string, limit, start int) ([]DashboardVersion, error) {
+	params := make(url.Values)
+	if start > 0 {
+		params.Set("start", strconv.Itoa(start))
+	}
+	page, err := r.getDashboardVersions(ctx, uid, limit, params)
+	return page.Versions, err
+}
+
+// GetDashboardVersionsPage gets the page of the dashboard versions from
+// the newest to the oldest. The continue token of the returned page gets
+// the next one, the empty token gets the first page. Limit restricts the
+// number of versions in the page, zero means default of Grafana.
+// Grafana before 11 returns all the versions in the single page.
+//
+// Reflects GET /api/dashboards/uid/:uid/versions API call.
+func (r *Client) GetDashboardVersionsPage(ctx context.Context, uid string, limit int, continueToken string) (DashboardVersionsPage, error) {
+	params := make(url.Values)
+	if continueToken != "" {
+		params.Set("continueToken", continueToken)
+	}
+	return r.getDashboardVersions(ctx, uid, limit, params)
+}
+
+func (r *Client) getDashboardVersions(ctx context.Context, uid string, limit int, params url.Values) (DashboardVersionsPage, error) {
+	var (
+		raw  []byte
+		page DashboardVersionsPage
+		err  error
+	)
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/dashboards/uid/%s/versions", uid), params); err != nil {
+		return DashboardVersionsPage{}, err
+	}
+	// Grafana 11 wraps the list in an object with continuation token
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '{' {
+		err = json.Unmarshal(raw, &page)
+		return page, err
+	}
+	err = json.Unmarshal(raw, &page.Versions)
+	return page, err
+}
+
+// GetDashboardVersion loads the dashboard as it was saved in the version.
+//
+// Reflects GET /api/dashboards/uid/:uid/versions/:version API call.
+func (r *Client) GetDashboardVersion(ctx context.Context, uid string, version int) (Board, DashboardVersion, error) {
+	raw, meta, err := r.GetRawDashboardVersion(ctx, uid, version)
+	if err != nil {
+		return Board{}, meta, err
+	}
+	var board Board
+	if err = decodeJSON(raw, &board); err != nil {
+		return Board{}, meta, fmt.Errorf("unmarshal board: %s", err)
+	}
+	return board, meta, nil
+}
+
+// GetRawDashboardVersion loads JSON of the dashboard as it was saved in
+// the version. Like GetRawDashboardByUID() it returns the JSON untouched.
+//
+// Reflects GET /api/dashboards/uid/:uid/versions/:version API call.
+func (r *Client) GetRawDashboardVersion(ctx context.Context, uid string, version int) ([]byte, DashboardVersion, error) {
+	var (
+		raw    []byte
+		result struct {
+			DashboardVersion
+			Data json.RawMessage `json:"data"`
+		}
+		err error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/dashboards/uid/%s/versions/%d", uid, version), nil); err != nil {
+		return nil, DashboardVersion{}, err
+	}
+	if err = decodeJSON(raw, &result); err != nil {
+		return nil, DashboardVersion{}, fmt.Errorf("unmarshal dashboard version: %s", err)
+	}
+	return []byte(result.Data), result.DashboardVersion, nil
+}
+
+// RestoreDashboardVersion saves the dashboard as it was in the version.
+// The restored dashboard gets a new version.
+//
+// Reflects POST /api/dashboards/uid/:uid/restore API call.
+func (r *Client) RestoreDashboardVersion(ctx context.Context, uid string, version int) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, err = json.Marshal(struct {
+		Version int `json:"version"`
+	}{version}); err != nil {
+		return StatusMessage{}, err
+	}
+	if raw, _, err = r.post(ctx, fmt.Sprintf("api/dashboards/uid/%s/restore", uid), nil, raw); err != nil {
+		return StatusMessage{}, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// CompareDashboardVersions loads two versions of the dashboard and returns
+// the changes from the base version to the changed one. See DiffDashboards
+// for the format of changes.
+func (r *Client) CompareDashboardVersions(ctx context.Context, uid string, base, changed int) ([]DashboardChange, error) {
+	a, _, err := r.GetRawDashboardVersion(ctx, uid, base)
+	if err != nil {
+		return nil, err
+	}
+	b, _, err := r.GetRawDashboardVersion(ctx, uid, changed)
+	if err != nil {
+		return nil, err
+	}
+	return DiffDashboards(a, b)
+}
@@ -0,0 +1,136 @@
+package sdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestDashboardVersions(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	for i, title := range []string{"Original", "Broken"} {
+		raw := fmt.Sprintf(`{"uid":"history","title":%q,"version":%d,"tags":["a"]}`, title, i)
+		if _, err := client.SetRawDashboard(ctx, []byte(raw)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	versions, err := client.GetDashboardVersions(ctx, "history", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || versions[0].Version != 1 {
+		t.Fatalf("expected the first version on the second page, got %+v", versions)
+	}
+	page, err := client.GetDashboardVersionsPage(ctx, "history", 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Versions) != 2 || page.ContinueToken != "" {
+		t.Errorf("expected all the versions in the single page, got %+v", page)
+	}
+
+	board, meta, err := client.GetDashboardVersion(ctx, "history", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if board.Title != "Original" || meta.Version != 1 || meta.CreatedBy != sdktest.AdminLogin {
+		t.Errorf("unexpected version %q with meta %+v", board.Title, meta)
+	}
+
+	changes, err := client.CompareDashboardVersions(ctx, "history", 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(changes) != `[~ title: "Original" -> "Broken" ~ version: 1 -> 2]` {
+		t.Errorf("unexpected changes %v", changes)
+	}
+
+	resp, err := client.RestoreDashboardVersion(ctx, "history", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *resp.Version != 3 {
+		t.Errorf("expected version 3 after restore, got %d", *resp.Version)
+	}
+	restored, _, err := client.GetDashboardByUID(ctx, "history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Title != "Original" {
+		t.Errorf("expected restored title, got %q", restored.Title)
+	}
+	if versions, err = client.GetDashboardVersions(ctx, "history", 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 3 || versions[0].RestoredFrom != 1 {
+		t.Errorf("unexpected versions after restore %+v", versions)
+	}
+}
+
+func TestGetDashboardVersions_Grafana11(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("continueToken") == "next" {
+			w.Write([]byte(`{"continueToken":"","versions":[{"id":1,"version":1}]}`))
+			return
+		}
+		w.Write([]byte(`{"continueToken":"next","versions":[{"id":3,"version":3,"message":"three"},{"id":2,"version":2}]}`))
+	}))
+	defer ts.Close()
+	ctx := context.Background()
+	client := sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()))
+	versions, err := client.GetDashboardVersions(ctx, "uid", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 2 || versions[0].Message != "three" {
+		t.Errorf("unexpected versions %+v", versions)
+	}
+
+	var all []sdk.DashboardVersion
+	page := sdk.DashboardVersionsPage{}
+	for {
+		if page, err = client.GetDashboardVersionsPage(ctx, "uid", 2, page.ContinueToken); err != nil {
+			t.Fatal(err)
+		}
+		all = append(all, page.Versions...)
+		if page.ContinueToken == "" {
+			break
+		}
+	}
+	if len(all) != 3 || all[2].Version != 1 {
+		t.Errorf("unexpected versions %+v", all)
+	}
+}
+
+func TestDiffDashboards(t *testing.T) {
+	base := []byte(`{"title":"A","panels":[{"id":1,"title":"CPU"},{"id":2}],"removed":true}`)
+	changed := []byte(`{"title":"A","panels":[{"id":1,"title":"Memory"}],"added":{"x":1}}`)
+	changes, err := sdk.DiffDashboards(base, changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		`+ added: {"x":1}`,
+		`~ panels[0].title: "CPU" -> "Memory"`,
+		`- panels[1]: {"id":2}`,
+		`- removed: true`,
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %v", len(expected), changes)
+	}
+	for i, c := range changes {
+		if c.String() != expected[i] {
+			t.Errorf("expected %s, got %s", expected[i], c)
+		}
+	}
+}
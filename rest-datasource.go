@@ -29,17 +29,13 @@ import (
 // Reflects GET /api/datasources API call.
 func (r *Client) GetAllDatasources(ctx context.Context) ([]Datasource, error) {
 	var (
-		raw  []byte
-		ds   []Datasource
-		code int
-		err  error
+		raw []byte
+		ds  []Datasource
+		err error
 	)
-	if raw, code, err = r.get(ctx, "api/datasources", nil); err != nil {
+	if raw, _, err = r.get(ctx, "api/datasources", nil); err != nil {
 		return nil, err
 	}
-	if code != 200 {
-		return nil, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &ds)
 	return ds, err
 }
@@ -48,17 +44,13 @@ func (r *Client) GetAllDatasources(ctx context.Context) ([]Datasource, error) {
 // Reflects GET /api/datasources/:datasourceId API call.
 func (r *Client) GetDatasource(ctx context.Context, id uint) (Datasource, error) {
 	var (
-		raw  []byte
-		ds   Datasource
-		code int
-		err  error
+		raw []byte
+		ds  Datasource
+		err error
 	)
-	if raw, code, err = r.get(ctx, fmt.Sprintf("api/datasources/%d", id), nil); err != nil {
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/datasources/%d", id), nil); err != nil {
 		return ds, err
 	}
-	if code != 200 {
-		return ds, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &ds)
 	return ds, err
 }
@@ -67,17 +59,13 @@ func (r *Client) GetDatasource(ctx context.Context, id uint) (Datasource, error)
 // Reflects GET /api/datasources/name/:datasourceName API call.
 func (r *Client) GetDatasourceByName(ctx context.Context, name string) (Datasource, error) {
 	var (
-		raw  []byte
-		ds   Datasource
-		code int
-		err  error
+		raw []byte
+		ds  Datasource
+		err error
 	)
-	if raw, code, err = r.get(ctx, fmt.Sprintf("api/datasources/name/%s", name), nil); err != nil {
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/datasources/name/%s", name), nil); err != nil {
 		return ds, err
 	}
-	if code != 200 {
-		return ds, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &ds)
 	return ds, err
 }
@@ -158,15 +146,11 @@ func (r *Client) GetDatasourceTypes(ctx context.Context) (map[string]DatasourceT
 	var (
 		raw     []byte
 		dsTypes = make(map[string]DatasourceType)
-		code    int
 		err     error
 	)
-	if raw, code, err = r.get(ctx, "api/datasources/plugins", nil); err != nil {
+	if raw, _, err = r.get(ctx, "api/datasources/plugins", nil); err != nil {
 		return nil, err
 	}
-	if code != 200 {
-		return nil, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &dsTypes)
 	return dsTypes, err
 }
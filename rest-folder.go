@@ -35,19 +35,15 @@ func (r *Client) GetAllFolders(ctx context.Context, params ...GetFolderParams) (
 	var (
 		raw           []byte
 		fs            []Folder
-		code          int
 		err           error
 		requestParams = make(url.Values)
 	)
 	for _, p := range params {
 		p(requestParams)
 	}
-	if raw, code, err = r.get(ctx, "api/folders", requestParams); err != nil {
+	if raw, _, err = r.get(ctx, "api/folders", requestParams); err != nil {
 		return nil, err
 	}
-	if code != 200 {
-		return nil, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &fs)
 	return fs, err
 }
@@ -56,17 +52,13 @@ func (r *Client) GetAllFolders(ctx context.Context, params ...GetFolderParams) (
 // Reflects GET /api/folders/:uid API call.
 func (r *Client) GetFolderByUID(ctx context.Context, UID string) (Folder, error) {
 	var (
-		raw  []byte
-		f    Folder
-		code int
-		err  error
+		raw []byte
+		f   Folder
+		err error
 	)
-	if raw, code, err = r.get(ctx, fmt.Sprintf("api/folders/%s", UID), nil); err != nil {
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/folders/%s", UID), nil); err != nil {
 		return f, err
 	}
-	if code != 200 {
-		return f, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &f)
 	return f, err
 }
@@ -75,21 +67,17 @@ func (r *Client) GetFolderByUID(ctx context.Context, UID string) (Folder, error)
 // Reflects POST /api/folders API call.
 func (r *Client) CreateFolder(ctx context.Context, f Folder) (Folder, error) {
 	var (
-		raw  []byte
-		rf   Folder
-		code int
-		err  error
+		raw []byte
+		rf  Folder
+		err error
 	)
 	rf = Folder{}
 	if raw, err = json.Marshal(f); err != nil {
 		return rf, err
 	}
-	if raw, code, err = r.post(ctx, "api/folders", nil, raw); err != nil {
+	if raw, _, err = r.post(ctx, "api/folders", nil, raw); err != nil {
 		return rf, err
 	}
-	if code != 200 {
-		return rf, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &rf)
 	return rf, err
 }
@@ -98,21 +86,17 @@ func (r *Client) CreateFolder(ctx context.Context, f Folder) (Folder, error) {
 // Reflects PUT /api/folders/:uid API call.
 func (r *Client) UpdateFolderByUID(ctx context.Context, f Folder) (Folder, error) {
 	var (
-		raw  []byte
-		rf   Folder
-		code int
-		err  error
+		raw []byte
+		rf  Folder
+		err error
 	)
 	rf = Folder{}
 	if raw, err = json.Marshal(f); err != nil {
 		return rf, err
 	}
-	if raw, code, err = r.put(ctx, fmt.Sprintf("api/folders/%s", f.UID), nil, raw); err != nil {
+	if raw, _, err = r.put(ctx, fmt.Sprintf("api/folders/%s", f.UID), nil, raw); err != nil {
 		return rf, err
 	}
-	if code != 200 {
-		return f, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &rf)
 	return rf, err
 }
@@ -120,38 +104,26 @@ func (r *Client) UpdateFolderByUID(ctx context.Context, f Folder) (Folder, error
 // DeleteFolderByUID deletes an existing folder by uid.
 // Reflects DELETE /api/folders/:uid API call.
 func (r *Client) DeleteFolderByUID(ctx context.Context, UID string) (bool, error) {
-	var (
-		raw  []byte
-		code int
-		err  error
-	)
-	if raw, code, err = r.delete(ctx, fmt.Sprintf("api/folders/%s", UID)); err != nil {
+	if _, _, err := r.delete(ctx, fmt.Sprintf("api/folders/%s", UID)); err != nil {
 		return false, err
 	}
-	if code != 200 {
-		return false, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
-	return true, err
+	return true, nil
 }
 
 // GetFolderByID gets folder by id.
 // Reflects GET /api/folders/id/:id API call.
 func (r *Client) GetFolderByID(ctx context.Context, ID int) (Folder, error) {
 	var (
-		raw  []byte
-		f    Folder
-		code int
-		err  error
+		raw []byte
+		f   Folder
+		err error
 	)
 	if ID <= 0 {
 		return f, fmt.Errorf("ID cannot be less than zero")
 	}
-	if raw, code, err = r.get(ctx, fmt.Sprintf("api/folders/id/%d", ID), nil); err != nil {
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/folders/id/%d", ID), nil); err != nil {
 		return f, err
 	}
-	if code != 200 {
-		return f, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	err = json.Unmarshal(raw, &f)
 	return f, err
 }
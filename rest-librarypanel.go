@@ -0,0 +1,203 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// https://grafana.com/docs/grafana/latest/developers/http_api/library_element/
+
+// Library elements appeared in Grafana v8, the calls below return
+// ErrUnsupported for older versions.
+
+// SearchLibraryElements searches library elements of the actual organization.
+// Reflects GET /api/library-elements API call.
+func (r *Client) SearchLibraryElements(ctx context.Context, params ...SearchLibraryElementsParams) (PageLibraryElements, error) {
+	var (
+		raw   []byte
+		reply struct {
+			Result PageLibraryElements `json:"result"`
+		}
+		err error
+	)
+	if err = r.require(ctx, CapLibraryPanels); err != nil {
+		return reply.Result, err
+	}
+	requestParams := make(url.Values)
+	for _, p := range params {
+		p(requestParams)
+	}
+	if raw, _, err = r.get(ctx, "api/library-elements", requestParams); err != nil {
+		return reply.Result, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply.Result, err
+}
+
+// GetLibraryElement gets the library element by its uid.
+// Reflects GET /api/library-elements/:uid API call.
+func (r *Client) GetLibraryElement(ctx context.Context, uid string) (LibraryElement, error) {
+	var (
+		raw   []byte
+		reply struct {
+			Result LibraryElement `json:"result"`
+		}
+		err error
+	)
+	if err = r.require(ctx, CapLibraryPanels); err != nil {
+		return reply.Result, err
+	}
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/library-elements/%s", uid), nil); err != nil {
+		return reply.Result, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply.Result, err
+}
+
+// CreateLibraryElement creates a new library element.
+// Reflects POST /api/library-elements API call.
+func (r *Client) CreateLibraryElement(ctx context.Context, req CreateLibraryElementRequest) (LibraryElement, error) {
+	var (
+		raw   []byte
+		reply struct {
+			Result LibraryElement `json:"result"`
+		}
+		err error
+	)
+	if err = r.require(ctx, CapLibraryPanels); err != nil {
+		return reply.Result, err
+	}
+	if raw, err = json.Marshal(req); err != nil {
+		return reply.Result, err
+	}
+	if raw, _, err = r.post(ctx, "api/library-elements", nil, raw); err != nil {
+		return reply.Result, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply.Result, err
+}
+
+// UpdateLibraryElement updates the library element. Grafana rejects the
+// update with 412 status if the version of the request is not the actual one.
+// Reflects PATCH /api/library-elements/:uid API call.
+func (r *Client) UpdateLibraryElement(ctx context.Context, uid string, req UpdateLibraryElementRequest) (LibraryElement, error) {
+	var (
+		raw   []byte
+		reply struct {
+			Result LibraryElement `json:"result"`
+		}
+		err error
+	)
+	if err = r.require(ctx, CapLibraryPanels); err != nil {
+		return reply.Result, err
+	}
+	if raw, err = json.Marshal(req); err != nil {
+		return reply.Result, err
+	}
+	if raw, _, err = r.patch(ctx, fmt.Sprintf("api/library-elements/%s", uid), nil, raw); err != nil {
+		return reply.Result, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply.Result, err
+}
+
+// DeleteLibraryElement deletes the library element. Elements used by
+// dashboards can't be deleted.
+// Reflects DELETE /api/library-elements/:uid API call.
+func (r *Client) DeleteLibraryElement(ctx context.Context, uid string) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if err = r.require(ctx, CapLibraryPanels); err != nil {
+		return reply, err
+	}
+	if raw, _, err = r.delete(ctx, fmt.Sprintf("api/library-elements/%s", uid)); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// GetLibraryElementConnections gets the dashboards using the library element.
+// Reflects GET /api/library-elements/:uid/connections API call.
+func (r *Client) GetLibraryElementConnections(ctx context.Context, uid string) ([]LibraryElementConnection, error) {
+	var (
+		raw   []byte
+		reply struct {
+			Result []LibraryElementConnection `json:"result"`
+		}
+		err error
+	)
+	if err = r.require(ctx, CapLibraryPanels); err != nil {
+		return nil, err
+	}
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/library-elements/%s/connections", uid), nil); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply.Result, err
+}
+
+// ExtractLibraryPanel creates the library panel from the panel and links
+// the panel to it. The dashboard of the panel should be saved after that
+// for connecting it to the library panel. See NewLibraryPanelRequest for
+// details.
+func (r *Client) ExtractLibraryPanel(ctx context.Context, p *Panel, name, folderUID string) (LibraryElement, error) {
+	req, err := NewLibraryPanelRequest(*p, name, folderUID)
+	if err != nil {
+		return LibraryElement{}, err
+	}
+	el, err := r.CreateLibraryElement(ctx, req)
+	if err != nil {
+		return el, err
+	}
+	p.LibraryPanel = &LibraryPanelRef{UID: el.UID, Name: el.Name}
+	return el, nil
+}
+
+// SearchLibraryElementsParams is the type for all options implementing
+// query parameters of the library elements search.
+type SearchLibraryElementsParams func(values url.Values)
+
+// LibraryElementQuery filters library elements by the name or description.
+func LibraryElementQuery(query string) SearchLibraryElementsParams {
+	return func(v url.Values) {
+		v.Set("searchString", query)
+	}
+}
+
+// LibraryElementKind filters library elements by the kind, see
+// LibraryElementPanel and LibraryElementVariable.
+func LibraryElementKind(kind int) SearchLibraryElementsParams {
+	return func(v url.Values) {
+		v.Set("kind", strconv.Itoa(kind))
+	}
+}
+
+// LibraryElementFolder filters library elements by the folders.
+func LibraryElementFolder(folderIDs ...int) SearchLibraryElementsParams {
+	return func(v url.Values) {
+		ids := ""
+		for i, id := range folderIDs {
+			if i > 0 {
+				ids += ","
+			}
+			ids += strconv.Itoa(id)
+		}
+		v.Set("folderFilter", ids)
+	}
+}
+
+// LibraryElementPage requests the page of the search result. Pages are
+// numbered from 1.
+func LibraryElementPage(page, perPage int) SearchLibraryElementsParams {
+	return func(v url.Values) {
+		v.Set("page", strconv.Itoa(page))
+		v.Set("perPage", strconv.Itoa(perPage))
+	}
+}
@@ -0,0 +1,104 @@
+package sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestLibraryPanelRef(t *testing.T) {
+	var p sdk.Panel
+	raw := `{"id":3,"gridPos":{"h":8,"w":12,"x":0,"y":0},"title":"Health","libraryPanel":{"uid":"health","name":"Service health"}}`
+	if err := json.Unmarshal([]byte(raw), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.OfType != sdk.LibraryPanelType || p.LibraryPanel == nil || p.LibraryPanel.UID != "health" {
+		t.Fatalf("unexpected panel %+v", p.CommonPanel)
+	}
+	out, err := json.Marshal(&p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(out); !strings.Contains(got, `"libraryPanel":{"uid":"health","name":"Service health"}`) || strings.Contains(got, `"type"`) {
+		t.Errorf("unexpected panel JSON %s", got)
+	}
+}
+
+func TestLibraryPanels(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	if _, err := client.SetRawDashboard(ctx, []byte(`{"uid":"api","title":"API","panels":[
+		{"id":2,"type":"stat","title":"Service health","gridPos":{"h":4,"w":6,"x":0,"y":0},"targets":[{"refId":"A","expr":"up"}]}
+	]}`)); err != nil {
+		t.Fatal(err)
+	}
+	board, _, err := client.GetDashboardByUID(ctx, "api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	el, err := client.ExtractLibraryPanel(ctx, board.Panels[0], "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if el.Name != "Service health" || el.Type != "stat" || el.Kind != sdk.LibraryElementPanel || board.Panels[0].LibraryPanel.UID != el.UID {
+		t.Fatalf("unexpected library panel %+v", el)
+	}
+	if strings.Contains(string(el.Model), "gridPos") {
+		t.Errorf("unexpected position in library panel model %s", el.Model)
+	}
+	if _, err = client.SetDashboard(ctx, board, sdk.SetDashboardParams{Overwrite: true}); err != nil {
+		t.Fatal(err)
+	}
+	conns, err := client.GetLibraryElementConnections(ctx, el.UID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conns) != 1 || conns[0].ConnectionUID != "api" {
+		t.Errorf("unexpected connections %+v", conns)
+	}
+	if _, err = client.DeleteLibraryElement(ctx, el.UID); !errors.Is(err, sdk.ErrForbidden) {
+		t.Errorf("expected connected library panel not deleted, got %v", err)
+	}
+
+	// the dashboard referencing the library panel only
+	ref := `{"uid":"ref","title":"Ref","panels":[{"id":7,"gridPos":{"h":8,"w":12,"x":0,"y":4},"title":"Health","libraryPanel":{"uid":"` + el.UID + `","name":"Service health"}}]}`
+	if _, err = client.SetRawDashboard(ctx, []byte(ref)); err != nil {
+		t.Fatal(err)
+	}
+	if board, _, err = client.GetDashboardByUID(ctx, "ref"); err != nil {
+		t.Fatal(err)
+	}
+	if err = board.ResolveLibraryPanels(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	p := board.Panels[0]
+	if p.OfType != sdk.StatType || p.ID != 7 || p.LibraryPanel == nil || p.Title != "Service health" ||
+		p.GetTargets() == nil || (*p.GetTargets())[0].Expr != "up" {
+		t.Errorf("unexpected resolved panel %+v", p.CommonPanel)
+	}
+
+	if _, err = client.UpdateLibraryElement(ctx, el.UID, sdk.UpdateLibraryElementRequest{Name: "Health", Kind: sdk.LibraryElementPanel, Version: 0}); err == nil {
+		t.Error("expected error for stale version")
+	}
+	if el, err = client.UpdateLibraryElement(ctx, el.UID, sdk.UpdateLibraryElementRequest{Name: "Health", Kind: sdk.LibraryElementPanel, Version: el.Version}); err != nil {
+		t.Fatal(err)
+	}
+	if el.Name != "Health" || el.Version != 2 || el.Meta.ConnectedDashboards != 2 {
+		t.Errorf("unexpected updated library panel %+v", el)
+	}
+	page, err := client.SearchLibraryElements(ctx, sdk.LibraryElementQuery("heal"), sdk.LibraryElementKind(sdk.LibraryElementPanel))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.TotalCount != 1 || page.Elements[0].UID != el.UID {
+		t.Errorf("unexpected search result %+v", page)
+	}
+}
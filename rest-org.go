@@ -24,7 +24,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 // CreateOrg creates a new organization.
@@ -53,16 +52,11 @@ func (r *Client) GetAllOrgs(ctx context.Context) ([]Org, error) {
 	var (
 		raw  []byte
 		orgs []Org
-		code int
 		err  error
 	)
-	if raw, code, err = r.get(ctx, "api/orgs", nil); err != nil {
+	if raw, _, err = r.get(ctx, "api/orgs", nil); err != nil {
 		return orgs, err
 	}
-
-	if code != http.StatusOK {
-		return orgs, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&orgs); err != nil {
@@ -75,17 +69,13 @@ func (r *Client) GetAllOrgs(ctx context.Context) ([]Org, error) {
 // It reflects GET /api/org API call.
 func (r *Client) GetActualOrg(ctx context.Context) (Org, error) {
 	var (
-		raw  []byte
-		org  Org
-		code int
-		err  error
+		raw []byte
+		org Org
+		err error
 	)
-	if raw, code, err = r.get(ctx, "api/org", nil); err != nil {
+	if raw, _, err = r.get(ctx, "api/org", nil); err != nil {
 		return org, err
 	}
-	if code != http.StatusOK {
-		return org, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&org); err != nil {
@@ -98,18 +88,13 @@ func (r *Client) GetActualOrg(ctx context.Context) (Org, error) {
 // It reflects GET /api/orgs/:orgId API call.
 func (r *Client) GetOrgById(ctx context.Context, oid uint) (Org, error) {
 	var (
-		raw  []byte
-		org  Org
-		code int
-		err  error
+		raw []byte
+		org Org
+		err error
 	)
-	if raw, code, err = r.get(ctx, fmt.Sprintf("api/orgs/%d", oid), nil); err != nil {
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/orgs/%d", oid), nil); err != nil {
 		return org, err
 	}
-
-	if code != http.StatusOK {
-		return org, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&org); err != nil {
@@ -122,18 +107,13 @@ func (r *Client) GetOrgById(ctx context.Context, oid uint) (Org, error) {
 // It reflects GET /api/orgs/name/:orgName API call.
 func (r *Client) GetOrgByOrgName(ctx context.Context, name string) (Org, error) {
 	var (
-		raw  []byte
-		org  Org
-		code int
-		err  error
+		raw []byte
+		org Org
+		err error
 	)
-	if raw, code, err = r.get(ctx, fmt.Sprintf("api/orgs/name/%s", name), nil); err != nil {
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/orgs/name/%s", name), nil); err != nil {
 		return org, err
 	}
-
-	if code != http.StatusOK {
-		return org, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&org); err != nil {
@@ -205,15 +185,11 @@ func (r *Client) GetActualOrgUsers(ctx context.Context) ([]OrgUser, error) {
 	var (
 		raw   []byte
 		users []OrgUser
-		code  int
 		err   error
 	)
-	if raw, code, err = r.get(ctx, "api/org/users", nil); err != nil {
+	if raw, _, err = r.get(ctx, "api/org/users", nil); err != nil {
 		return nil, err
 	}
-	if code != http.StatusOK {
-		return nil, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&users); err != nil {
@@ -228,15 +204,11 @@ func (r *Client) GetOrgUsers(ctx context.Context, oid uint) ([]OrgUser, error) {
 	var (
 		raw   []byte
 		users []OrgUser
-		code  int
 		err   error
 	)
-	if raw, code, err = r.get(ctx, fmt.Sprintf("api/orgs/%d/users", oid), nil); err != nil {
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/orgs/%d/users", oid), nil); err != nil {
 		return nil, err
 	}
-	if code != http.StatusOK {
-		return nil, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&users); err != nil {
@@ -377,16 +349,11 @@ func (r *Client) GetActualOrgPreferences(ctx context.Context) (Preferences, erro
 	var (
 		raw  []byte
 		pref Preferences
-		code int
 		err  error
 	)
-	if raw, code, err = r.get(ctx, "/api/org/preferences", nil); err != nil {
+	if raw, _, err = r.get(ctx, "/api/org/preferences", nil); err != nil {
 		return pref, err
 	}
-
-	if code != http.StatusOK {
-		return pref, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&pref); err != nil {
@@ -395,6 +362,13 @@ func (r *Client) GetActualOrgPreferences(ctx context.Context) (Preferences, erro
 	return pref, err
 }
 
+// PatchActualOrgPreferences changes only the set preferences of the
+// actual organization.
+// Reflects PATCH /api/org/preferences API call.
+func (r *Client) PatchActualOrgPreferences(ctx context.Context, prefs PatchPreferences) (StatusMessage, error) {
+	return r.patchPreferences(ctx, "api/org/preferences", prefs)
+}
+
 // UpdateActualOrgAddress updates current organization's address.
 // It reflects PUT /api/org/address API call.
 func (r *Client) UpdateActualOrgAddress(ctx context.Context, address Address) (StatusMessage, error) {
@@ -0,0 +1,68 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// GetDashboardPermissionsByUID gets the ACL of the dashboard including
+// the grants inherited from its folder.
+//
+// Reflects GET /api/dashboards/uid/:uid/permissions API call.
+func (r *Client) GetDashboardPermissionsByUID(ctx context.Context, uid string) ([]PermissionItem, error) {
+	return r.getPermissions(ctx, fmt.Sprintf("api/dashboards/uid/%s/permissions", uid))
+}
+
+// UpdateDashboardPermissionsByUID replaces the ACL of the dashboard with
+// the items. Inherited items are skipped. Use MergePermission for changing
+// a single grant.
+//
+// Reflects POST /api/dashboards/uid/:uid/permissions API call.
+func (r *Client) UpdateDashboardPermissionsByUID(ctx context.Context, uid string, items []PermissionItem) (StatusMessage, error) {
+	return r.updatePermissions(ctx, fmt.Sprintf("api/dashboards/uid/%s/permissions", uid), items)
+}
+
+// GetFolderPermissions gets the ACL of the folder.
+//
+// Reflects GET /api/folders/:uid/permissions API call.
+func (r *Client) GetFolderPermissions(ctx context.Context, folderUID string) ([]PermissionItem, error) {
+	return r.getPermissions(ctx, fmt.Sprintf("api/folders/%s/permissions", folderUID))
+}
+
+// UpdateFolderPermissions replaces the ACL of the folder with the items.
+// Dashboards of the folder inherit the permissions.
+//
+// Reflects POST /api/folders/:uid/permissions API call.
+func (r *Client) UpdateFolderPermissions(ctx context.Context, folderUID string, items []PermissionItem) (StatusMessage, error) {
+	return r.updatePermissions(ctx, fmt.Sprintf("api/folders/%s/permissions", folderUID), items)
+}
+
+func (r *Client) getPermissions(ctx context.Context, query string) ([]PermissionItem, error) {
+	var (
+		raw   []byte
+		items []PermissionItem
+		err   error
+	)
+	if raw, _, err = r.get(ctx, query, nil); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, &items)
+	return items, err
+}
+
+func (r *Client) updatePermissions(ctx context.Context, query string, items []PermissionItem) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, err = json.Marshal(newPermissionUpdate(items)); err != nil {
+		return StatusMessage{}, err
+	}
+	if raw, _, err = r.post(ctx, query, nil, raw); err != nil {
+		return StatusMessage{}, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
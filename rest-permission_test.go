@@ -0,0 +1,90 @@
+package sdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestMergePermission(t *testing.T) {
+	acl := []sdk.PermissionItem{
+		sdk.RolePermission("Viewer", sdk.PermissionView),
+		sdk.TeamPermission(3, sdk.PermissionEdit),
+		{UserID: 5, Permission: sdk.PermissionView, Inherited: true},
+	}
+	acl = sdk.MergePermission(acl, sdk.TeamPermission(3, sdk.PermissionAdmin))
+	acl = sdk.MergePermission(acl, sdk.UserPermission(7, sdk.PermissionEdit))
+	if len(acl) != 3 || acl[1].Permission != sdk.PermissionAdmin || acl[2].UserID != 7 {
+		t.Errorf("unexpected merged ACL %+v", acl)
+	}
+	acl = sdk.RemovePermission(acl, sdk.RolePermission("Viewer", 0))
+	if len(acl) != 2 || acl[0].TeamID != 3 {
+		t.Errorf("unexpected ACL after removal %+v", acl)
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	folder, err := client.CreateFolder(ctx, sdk.Folder{Title: "Owned"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.SetRawDashboard(ctx, []byte(`{"uid":"owned","title":"Owned board"}`)); err != nil {
+		t.Fatal(err)
+	}
+	board, _, err := client.GetDashboardByUID(ctx, "owned")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.SetDashboard(ctx, board, sdk.SetDashboardParams{FolderID: folder.ID, Overwrite: true}); err != nil {
+		t.Fatal(err)
+	}
+	user, err := client.CreateUser(ctx, sdk.User{Login: "owner", Password: "owner"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acl, err := client.GetFolderPermissions(ctx, folder.UID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acl = sdk.MergePermission(acl, sdk.UserPermission(*user.ID, sdk.PermissionAdmin))
+	acl = sdk.RemovePermission(acl, sdk.RolePermission("Editor", 0))
+	if _, err = client.UpdateFolderPermissions(ctx, folder.UID, acl); err != nil {
+		t.Fatal(err)
+	}
+	if acl, err = client.GetFolderPermissions(ctx, folder.UID); err != nil {
+		t.Fatal(err)
+	}
+	if len(acl) != 2 || acl[1].UserLogin != "owner" || acl[1].PermissionName != "Admin" {
+		t.Errorf("unexpected folder ACL %+v", acl)
+	}
+
+	acl, err = client.GetDashboardPermissionsByUID(ctx, "owned")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(acl) != 2 || !acl[0].Inherited {
+		t.Fatalf("expected inherited folder ACL, got %+v", acl)
+	}
+	teamID, err := client.CreateTeam(ctx, sdk.TeamRequest{Name: "editors"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	acl = sdk.MergePermission(acl, sdk.TeamPermission(teamID, sdk.PermissionEdit))
+	if _, err = client.UpdateDashboardPermissionsByUID(ctx, "owned", acl); err != nil {
+		t.Fatal(err)
+	}
+	if acl, err = client.GetDashboardPermissionsByUID(ctx, "owned"); err != nil {
+		t.Fatal(err)
+	}
+	if len(acl) != 3 || acl[0].TeamID != teamID || acl[0].Team != "editors" || acl[0].Inherited {
+		t.Errorf("unexpected dashboard ACL %+v", acl)
+	}
+}
@@ -0,0 +1,172 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// https://grafana.com/docs/grafana/latest/developers/http_api/playlist/
+
+// Grafana before v9 selects playlists by numeric ids, the uid arguments
+// of the calls below take the id as a string for such versions.
+
+// GetPlaylists gets playlists of the actual organization without items.
+// Empty query and zero limit are not sent.
+// Reflects GET /api/playlists API call.
+func (r *Client) GetPlaylists(ctx context.Context, query string, limit int) ([]Playlist, error) {
+	var (
+		raw       []byte
+		playlists []Playlist
+		params    = make(url.Values)
+		err       error
+	)
+	if query != "" {
+		params.Set("query", query)
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if raw, _, err = r.get(ctx, "api/playlists", params); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, &playlists)
+	return playlists, err
+}
+
+// GetPlaylist gets the playlist with its items.
+// Reflects GET /api/playlists/:uid API call.
+func (r *Client) GetPlaylist(ctx context.Context, uid string) (Playlist, error) {
+	var (
+		raw      []byte
+		playlist Playlist
+		err      error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/playlists/%s", uid), nil); err != nil {
+		return playlist, err
+	}
+	err = json.Unmarshal(raw, &playlist)
+	return playlist, err
+}
+
+// GetPlaylistItems gets items of the playlist.
+// Reflects GET /api/playlists/:uid/items API call.
+func (r *Client) GetPlaylistItems(ctx context.Context, uid string) ([]PlaylistItem, error) {
+	var (
+		raw   []byte
+		items []PlaylistItem
+		err   error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/playlists/%s/items", uid), nil); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, &items)
+	return items, err
+}
+
+// CreatePlaylist creates a new playlist with the items.
+// Reflects POST /api/playlists API call.
+func (r *Client) CreatePlaylist(ctx context.Context, playlist Playlist) (Playlist, error) {
+	var (
+		raw     []byte
+		created Playlist
+		err     error
+	)
+	if raw, err = json.Marshal(playlist); err != nil {
+		return created, err
+	}
+	if raw, _, err = r.post(ctx, "api/playlists", nil, raw); err != nil {
+		return created, err
+	}
+	err = json.Unmarshal(raw, &created)
+	return created, err
+}
+
+// UpdatePlaylist replaces the name, interval and items of the playlist.
+// Reflects PUT /api/playlists/:uid API call.
+func (r *Client) UpdatePlaylist(ctx context.Context, uid string, playlist Playlist) (Playlist, error) {
+	var (
+		raw     []byte
+		updated Playlist
+		err     error
+	)
+	if raw, err = json.Marshal(playlist); err != nil {
+		return updated, err
+	}
+	if raw, _, err = r.put(ctx, fmt.Sprintf("api/playlists/%s", uid), nil, raw); err != nil {
+		return updated, err
+	}
+	err = json.Unmarshal(raw, &updated)
+	return updated, err
+}
+
+// DeletePlaylist deletes the playlist.
+// Reflects DELETE /api/playlists/:uid API call.
+func (r *Client) DeletePlaylist(ctx context.Context, uid string) error {
+	_, _, err := r.delete(ctx, fmt.Sprintf("api/playlists/%s", uid))
+	return err
+}
+
+// ResolvePlaylist returns dashboards shown by the playlist in order of
+// its items. Dashboards of the tag items are ordered by titles as Search
+// returns them, missing dashboards are skipped.
+func (r *Client) ResolvePlaylist(ctx context.Context, playlist Playlist) ([]FoundBoard, error) {
+	var (
+		params   []SearchParam
+		byUID    = make(map[string]FoundBoard)
+		byID     = make(map[uint]FoundBoard)
+		byTag    = make(map[string][]FoundBoard)
+		resolved []FoundBoard
+	)
+	for _, item := range playlist.Items {
+		switch item.Type {
+		case PlaylistItemByUID:
+			params = append(params, SearchDashboardUID(item.Value))
+		case PlaylistItemByID:
+			id, err := strconv.Atoi(item.Value)
+			if err != nil {
+				return nil, fmt.Errorf("playlist item %q: invalid dashboard id", item.Value)
+			}
+			params = append(params, SearchDashboardID(id))
+		case PlaylistItemByTag:
+			if _, ok := byTag[item.Value]; ok {
+				continue
+			}
+			boards, err := r.SearchAll(ctx, SearchType(SearchTypeDashboard), SearchTag(item.Value))
+			if err != nil {
+				return nil, err
+			}
+			byTag[item.Value] = boards
+		default:
+			return nil, fmt.Errorf("playlist item %q: unknown type %q", item.Value, item.Type)
+		}
+	}
+	if len(params) > 0 {
+		boards, err := r.SearchAll(ctx, append(params, SearchType(SearchTypeDashboard))...)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range boards {
+			byUID[b.UID] = b
+			byID[b.ID] = b
+		}
+	}
+	for _, item := range playlist.Items {
+		switch item.Type {
+		case PlaylistItemByUID:
+			if b, ok := byUID[item.Value]; ok {
+				resolved = append(resolved, b)
+			}
+		case PlaylistItemByID:
+			id, _ := strconv.Atoi(item.Value)
+			if b, ok := byID[uint(id)]; ok {
+				resolved = append(resolved, b)
+			}
+		case PlaylistItemByTag:
+			resolved = append(resolved, byTag[item.Value]...)
+		}
+	}
+	return resolved, nil
+}
@@ -0,0 +1,79 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestPlaylists(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	for _, raw := range []string{
+		`{"uid":"overview","title":"Overview"}`,
+		`{"uid":"db","title":"DB","tags":["noc"]}`,
+		`{"uid":"api","title":"API","tags":["noc"]}`,
+	} {
+		if _, err := client.SetRawDashboard(ctx, []byte(raw)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	playlist, err := client.CreatePlaylist(ctx, sdk.Playlist{
+		Name:     "NOC",
+		Interval: "5m",
+		Items: []sdk.PlaylistItem{
+			{Type: sdk.PlaylistItemByUID, Value: "overview"},
+			{Type: sdk.PlaylistItemByTag, Value: "noc"},
+			{Type: sdk.PlaylistItemByUID, Value: "removed"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if playlist.UID == "" || len(playlist.Items) != 3 || playlist.Items[2].Order != 3 {
+		t.Fatalf("unexpected playlist %+v", playlist)
+	}
+	boards, err := client.ResolvePlaylist(ctx, playlist)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var uids []string
+	for _, b := range boards {
+		uids = append(uids, b.UID)
+	}
+	if len(uids) != 3 || uids[0] != "overview" || uids[1] != "api" || uids[2] != "db" {
+		t.Errorf("unexpected resolved dashboards %v", uids)
+	}
+
+	playlist.Interval = "1m"
+	playlist.Items = playlist.Items[:1]
+	if _, err = client.UpdatePlaylist(ctx, playlist.UID, playlist); err != nil {
+		t.Fatal(err)
+	}
+	items, err := client.GetPlaylistItems(ctx, playlist.UID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 1 || items[0].Value != "overview" {
+		t.Errorf("unexpected items %+v", items)
+	}
+	list, err := client.GetPlaylists(ctx, "no", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Interval != "1m" || list[0].Items != nil {
+		t.Errorf("unexpected playlists %+v", list)
+	}
+	if err = client.DeletePlaylist(ctx, playlist.UID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.GetPlaylist(ctx, playlist.UID); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found after deletion, got %v", err)
+	}
+}
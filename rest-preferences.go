@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// https://grafana.com/docs/grafana/latest/developers/http_api/preferences/
+
+// GetActualUserPreferences gets preferences of the actual user.
+// Reflects GET /api/user/preferences API call.
+func (r *Client) GetActualUserPreferences(ctx context.Context) (Preferences, error) {
+	return r.getPreferences(ctx, "api/user/preferences")
+}
+
+// UpdateActualUserPreferences replaces preferences of the actual user,
+// the preferences not set are reset to the inherited ones.
+// Reflects PUT /api/user/preferences API call.
+func (r *Client) UpdateActualUserPreferences(ctx context.Context, prefs Preferences) (StatusMessage, error) {
+	return r.updatePreferences(ctx, "api/user/preferences", prefs)
+}
+
+// PatchActualUserPreferences changes only the set preferences of the
+// actual user.
+// Reflects PATCH /api/user/preferences API call.
+func (r *Client) PatchActualUserPreferences(ctx context.Context, prefs PatchPreferences) (StatusMessage, error) {
+	return r.patchPreferences(ctx, "api/user/preferences", prefs)
+}
+
+func (r *Client) getPreferences(ctx context.Context, query string) (Preferences, error) {
+	var (
+		raw  []byte
+		pref Preferences
+		err  error
+	)
+	if raw, _, err = r.get(ctx, query, nil); err != nil {
+		return pref, err
+	}
+	if err = decodeJSON(raw, &pref); err != nil {
+		return pref, fmt.Errorf("unmarshal prefs: %w", err)
+	}
+	return pref, nil
+}
+
+// updatePreferences replaces the preferences with PUT request.
+func (r *Client) updatePreferences(ctx context.Context, query string, prefs Preferences) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, err = json.Marshal(prefs); err != nil {
+		return reply, err
+	}
+	if raw, _, err = r.put(ctx, query, nil, raw); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// patchPreferences changes the set preferences with PATCH request.
+func (r *Client) patchPreferences(ctx context.Context, query string, prefs PatchPreferences) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, err = json.Marshal(prefs); err != nil {
+		return reply, err
+	}
+	if raw, _, err = r.patch(ctx, query, nil, raw); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
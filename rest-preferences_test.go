@@ -0,0 +1,152 @@
+package sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestCookiePreferences_UnmarshalJSON(t *testing.T) {
+	want := sdk.CookiePreferences{sdk.CookieAnalytics, sdk.CookieFunctional}
+	for _, raw := range []string{
+		`["analytics","functional"]`,
+		`{"functional":{},"analytics":{}}`,
+	} {
+		var got sdk.CookiePreferences
+		if err := json.Unmarshal([]byte(raw), &got); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %v", raw, got)
+		}
+	}
+}
+
+func TestPreferences_MarshalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		prefs    sdk.Preferences
+		expected string
+	}{
+		{sdk.Preferences{Theme: "dark", Cookies: []string{sdk.CookieAnalytics}}, `{"theme":"dark","cookies":["analytics"]}`},
+		{sdk.Preferences{CookiePreferences: sdk.CookiePreferences{sdk.CookieFunctional}}, `{"cookies":["functional"]}`},
+	} {
+		raw, err := json.Marshal(tc.prefs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(raw) != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, raw)
+		}
+	}
+}
+
+func TestPatchPreferences_OnlySetFields(t *testing.T) {
+	var (
+		theme = "light"
+		home  uint
+	)
+	raw, err := json.Marshal(sdk.PatchPreferences{Theme: &theme, HomeDashboardId: &home, Cookies: &[]string{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != `{"theme":"light","homeDashboardId":0,"cookies":[]}` {
+		t.Errorf("unexpected patch %s", raw)
+	}
+}
+
+func TestPreferences(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	for _, uid := range []string{"org-home", "team-home", "user-home"} {
+		board := sdk.NewBoard(uid)
+		board.UID = uid
+		if _, err := client.SetDashboard(ctx, *board, sdk.SetDashboardParams{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := client.UpdateActualUserPreferences(ctx, sdk.Preferences{
+		Theme:        "dark",
+		WeekStart:    "monday",
+		Locale:       "en-GB",
+		QueryHistory: &sdk.QueryHistoryPreference{HomeTab: "starred"},
+		Navbar:       &sdk.NavbarPreference{BookmarkURLs: []string{"/d/user-home"}},
+		Cookies:      []string{sdk.CookiePerformance},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	timezone := "utc"
+	if _, err := client.PatchActualUserPreferences(ctx, sdk.PatchPreferences{Timezone: &timezone}); err != nil {
+		t.Fatal(err)
+	}
+	prefs, err := client.GetActualUserPreferences(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prefs.Theme != "dark" || prefs.Timezone != "utc" || prefs.WeekStart != "monday" || prefs.Locale != "en-GB" ||
+		prefs.QueryHistory == nil || prefs.QueryHistory.HomeTab != "starred" ||
+		prefs.Navbar == nil || len(prefs.Navbar.BookmarkURLs) != 1 ||
+		!reflect.DeepEqual(prefs.CookiePreferences, sdk.CookiePreferences{sdk.CookiePerformance}) {
+		t.Errorf("unexpected user preferences %+v", prefs)
+	}
+	if _, err = client.UpdateActualUserPreferences(ctx, prefs); err != nil {
+		t.Fatal(err)
+	}
+	if prefs, err = client.GetActualUserPreferences(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(prefs.CookiePreferences, sdk.CookiePreferences{sdk.CookiePerformance}) {
+		t.Errorf("expected cookie consent kept after saving loaded preferences, got %+v", prefs)
+	}
+
+	home := "org-home"
+	if _, err = client.PatchActualOrgPreferences(ctx, sdk.PatchPreferences{HomeDashboardUID: &home}); err != nil {
+		t.Fatal(err)
+	}
+	if h, err := client.GetHomeDashboard(ctx); err != nil || h.RedirectUID != "org-home" {
+		t.Errorf("expected org home dashboard, got %+v: %v", h, err)
+	}
+
+	teamID, err := client.CreateTeam(ctx, sdk.TeamRequest{Name: "ops"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := client.GetActualUser(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.AddTeamMember(ctx, teamID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.UpdateTeamPreferences(ctx, teamID, sdk.Preferences{Theme: "light"}); err != nil {
+		t.Fatal(err)
+	}
+	home = "team-home"
+	if _, err = client.PatchTeamPreferences(ctx, teamID, sdk.PatchPreferences{HomeDashboardUID: &home}); err != nil {
+		t.Fatal(err)
+	}
+	if prefs, err = client.GetTeamPreferences(ctx, teamID); err != nil {
+		t.Fatal(err)
+	}
+	if prefs.Theme != "light" || prefs.HomeDashboardUID != "team-home" {
+		t.Errorf("unexpected team preferences %+v", prefs)
+	}
+	if h, err := client.GetHomeDashboard(ctx); err != nil || h.RedirectUID != "team-home" {
+		t.Errorf("expected team home dashboard, got %+v: %v", h, err)
+	}
+
+	home = "user-home"
+	if _, err = client.PatchActualUserPreferences(ctx, sdk.PatchPreferences{HomeDashboardUID: &home}); err != nil {
+		t.Fatal(err)
+	}
+	if h, err := client.GetHomeDashboard(ctx); err != nil || h.RedirectUID != "user-home" {
+		t.Errorf("expected user home dashboard, got %+v: %v", h, err)
+	}
+}
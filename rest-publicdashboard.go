@@ -0,0 +1,139 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+// GetPublicDashboards gets all public dashboards of the organization.
+// Grafana 10.2 and above pages the list, all the pages are requested
+// then.
+//
+// Reflects GET /api/dashboards/public-dashboards API call.
+func (r *Client) GetPublicDashboards(ctx context.Context) ([]FoundPublicDashboard, error) {
+	var (
+		raw  []byte
+		list []FoundPublicDashboard
+		err  error
+	)
+	if err = r.require(ctx, CapPublicDashboards); err != nil {
+		return nil, err
+	}
+	for page := 1; ; page++ {
+		params := url.Values{
+			"page":    {strconv.Itoa(page)},
+			"perpage": {strconv.Itoa(DefaultPageSize)},
+		}
+		if raw, _, err = r.get(ctx, "api/dashboards/public-dashboards", params); err != nil {
+			return nil, err
+		}
+		// Grafana before 10.2 returns the whole list without paging
+		raw = bytes.TrimSpace(raw)
+		if len(raw) == 0 || raw[0] != '{' {
+			err = json.Unmarshal(raw, &list)
+			return list, err
+		}
+		var reply struct {
+			PublicDashboards []FoundPublicDashboard `json:"publicDashboards"`
+			TotalCount       int                    `json:"totalCount"`
+		}
+		if err = json.Unmarshal(raw, &reply); err != nil {
+			return nil, err
+		}
+		list = append(list, reply.PublicDashboards...)
+		if len(reply.PublicDashboards) == 0 || len(list) >= reply.TotalCount {
+			return list, nil
+		}
+	}
+}
+
+// GetPublicDashboard gets the public dashboard config of the dashboard.
+// It returns ErrNotFound if the dashboard isn't shared.
+//
+// Reflects GET /api/dashboards/uid/:dashboardUid/public-dashboards API call.
+func (r *Client) GetPublicDashboard(ctx context.Context, dashboardUID string) (PublicDashboard, error) {
+	var (
+		raw    []byte
+		public PublicDashboard
+		err    error
+	)
+	if err = r.require(ctx, CapPublicDashboards); err != nil {
+		return public, err
+	}
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/dashboards/uid/%s/public-dashboards", dashboardUID), nil); err != nil {
+		return public, err
+	}
+	err = json.Unmarshal(raw, &public)
+	return public, err
+}
+
+// CreatePublicDashboard shares the dashboard publicly with the config.
+// Grafana generates the uid and access token unless they are set.
+//
+// Reflects POST /api/dashboards/uid/:dashboardUid/public-dashboards API call.
+func (r *Client) CreatePublicDashboard(ctx context.Context, dashboardUID string, public PublicDashboard) (PublicDashboard, error) {
+	var (
+		raw     []byte
+		created PublicDashboard
+		err     error
+	)
+	if err = r.require(ctx, CapPublicDashboards); err != nil {
+		return created, err
+	}
+	if raw, err = json.Marshal(public); err != nil {
+		return created, err
+	}
+	if raw, _, err = r.post(ctx, fmt.Sprintf("api/dashboards/uid/%s/public-dashboards", dashboardUID), nil, raw); err != nil {
+		return created, err
+	}
+	err = json.Unmarshal(raw, &created)
+	return created, err
+}
+
+// UpdatePublicDashboard updates the public dashboard config selected
+// by its uid.
+//
+// Reflects PATCH /api/dashboards/uid/:dashboardUid/public-dashboards/:uid API call.
+func (r *Client) UpdatePublicDashboard(ctx context.Context, public PublicDashboard) (PublicDashboard, error) {
+	var (
+		raw     []byte
+		updated PublicDashboard
+		err     error
+	)
+	if err = r.require(ctx, CapPublicDashboards); err != nil {
+		return updated, err
+	}
+	if raw, err = json.Marshal(public); err != nil {
+		return updated, err
+	}
+	if raw, _, err = r.patch(ctx, fmt.Sprintf("api/dashboards/uid/%s/public-dashboards/%s", public.DashboardUID, public.UID), nil, raw); err != nil {
+		return updated, err
+	}
+	err = json.Unmarshal(raw, &updated)
+	return updated, err
+}
+
+// DeletePublicDashboard stops sharing the dashboard publicly.
+//
+// Reflects DELETE /api/dashboards/uid/:dashboardUid/public-dashboards/:uid API call.
+func (r *Client) DeletePublicDashboard(ctx context.Context, dashboardUID, uid string) error {
+	if err := r.require(ctx, CapPublicDashboards); err != nil {
+		return err
+	}
+	_, _, err := r.delete(ctx, fmt.Sprintf("api/dashboards/uid/%s/public-dashboards/%s", dashboardUID, uid))
+	return err
+}
+
+// PublicDashboardURL returns the URL the dashboard is shared publicly at
+// by the access token. Credentials of the client are never included.
+func (r *Client) PublicDashboardURL(accessToken string) string {
+	u, _ := url.Parse(r.baseURL)
+	u.User = nil
+	u.Path = path.Join("/", u.Path, "public-dashboards", accessToken)
+	return u.String()
+}
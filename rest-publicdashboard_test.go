@@ -0,0 +1,95 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestPublicDashboards(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	if _, err := client.SetRawDashboard(ctx, []byte(`{"uid":"status","title":"Status"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetPublicDashboard(ctx, "status"); !errors.Is(err, sdk.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	public, err := client.CreatePublicDashboard(ctx, "status", sdk.PublicDashboard{IsEnabled: true, TimeSelectionEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if public.UID == "" || public.AccessToken == "" || public.DashboardUID != "status" || public.Share != sdk.PublicDashboardSharePublic {
+		t.Fatalf("unexpected public dashboard %+v", public)
+	}
+	public.AnnotationsEnabled = true
+	public.IsEnabled = false
+	if public, err = client.UpdatePublicDashboard(ctx, public); err != nil {
+		t.Fatal(err)
+	}
+	if !public.AnnotationsEnabled || public.IsEnabled || !public.TimeSelectionEnabled {
+		t.Errorf("unexpected updated public dashboard %+v", public)
+	}
+	list, err := client.GetPublicDashboards(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Title != "Status" || list[0].AccessToken != public.AccessToken {
+		t.Errorf("unexpected public dashboards %+v", list)
+	}
+
+	if got, want := client.PublicDashboardURL(public.AccessToken), srv.URL+"/public-dashboards/"+public.AccessToken; got != want {
+		t.Errorf("expected public URL %s, got %s", want, got)
+	}
+
+	if err = client.DeletePublicDashboard(ctx, "status", public.UID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.GetPublicDashboard(ctx, "status"); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found after deletion, got %v", err)
+	}
+}
+
+func TestPublicDashboards_Unsupported(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	srv.Version = sdktest.LegacyVersion
+	if _, err := srv.Client.GetPublicDashboards(context.Background()); !errors.Is(err, sdk.ErrUnsupported) {
+		t.Errorf("expected unsupported error, got %v", err)
+	}
+}
+
+func TestGetPublicDashboards_Pages(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/health" {
+			w.Write([]byte(`{"version":"10.2.0"}`))
+			return
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"totalCount":3,"page":1,"perPage":2,"publicDashboards":[{"uid":"a"},{"uid":"b"}]}`))
+		case "2":
+			w.Write([]byte(`{"totalCount":3,"page":2,"perPage":2,"publicDashboards":[{"uid":"c"}]}`))
+		default:
+			t.Errorf("unexpected request of page %q", r.URL.Query().Get("page"))
+			w.Write([]byte(`{"totalCount":3,"publicDashboards":[]}`))
+		}
+	}))
+	defer ts.Close()
+	client := sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()))
+	list, err := client.GetPublicDashboards(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 3 || list[2].UID != "c" {
+		t.Errorf("expected public dashboards of all pages, got %+v", list)
+	}
+}
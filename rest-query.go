@@ -0,0 +1,37 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// QueryDatasources runs the queries through the datasources and returns
+// the results by refId of the queries. Grafana answers with 400 status
+// when some of the queries fail, the results are decoded from such reply
+// too and returned along with the *APIError, so the errors of the single
+// queries could be checked.
+//
+// Reflects POST /api/ds/query API call.
+func (r *Client) QueryDatasources(ctx context.Context, req DatasourceQueryRequest) (DatasourceQueryResponse, error) {
+	var (
+		raw    []byte
+		resp   DatasourceQueryResponse
+		apiErr *APIError
+		err    error
+	)
+	if raw, err = json.Marshal(req); err != nil {
+		return resp, err
+	}
+	if raw, _, err = r.post(ctx, "api/ds/query", nil, raw); err != nil {
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+			return resp, err
+		}
+		raw = apiErr.Body
+	}
+	if decodeErr := json.Unmarshal(raw, &resp); decodeErr != nil && err == nil {
+		return resp, decodeErr
+	}
+	return resp, err
+}
@@ -26,10 +26,11 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"path"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // DefaultHTTPClient initialized Grafana with appropriate conditions.
@@ -43,7 +44,14 @@ type Client struct {
 	basicAuth     bool
 	anonymousAuth bool
 	client        *http.Client
-	debug         bool
+	retry         RetryPolicy
+	headers       http.Header
+	userAgent     string
+	orgID         uint
+	timeout       time.Duration
+	hooks         []Hooks
+	limiter       *RateLimiter
+	version       *serverVersion
 }
 
 // StatusMessage reflects status message as it returned by Grafana REST API.
@@ -60,23 +68,18 @@ type StatusMessage struct {
 
 // NewClient initializes client for interacting with an instance of Grafana server;
 // apiKeyOrBasicAuth accepts either 'username:password' basic authentication credentials,
-// or a Grafana API key
+// or a Grafana API key. Use NewClientWithOptions for more precise configuration.
 func NewClient(apiURL, apiKeyOrBasicAuth string, client *http.Client, debug bool) *Client {
-	key := ""
-	var anonymousAuth bool
-	if apiKeyOrBasicAuth == "" {
-		anonymousAuth = true
+	opts := []ClientOption{WithHTTPClient(client), WithDebug(debug)}
+	switch {
+	case apiKeyOrBasicAuth == "":
+	case strings.Contains(apiKeyOrBasicAuth, ":"):
+		parts := strings.SplitN(apiKeyOrBasicAuth, ":", 2)
+		opts = append(opts, WithBasicAuth(parts[0], parts[1]))
+	default:
+		opts = append(opts, WithAPIKey(apiKeyOrBasicAuth))
 	}
-	basicAuth := strings.Contains(apiKeyOrBasicAuth, ":")
-	baseURL, _ := url.Parse(apiURL)
-	if !basicAuth && !anonymousAuth {
-		key = fmt.Sprintf("Bearer %s", apiKeyOrBasicAuth)
-	}
-	if basicAuth {
-		parts := strings.Split(apiKeyOrBasicAuth, ":")
-		baseURL.User = url.UserPassword(parts[0], parts[1])
-	}
-	return &Client{baseURL: baseURL.String(), basicAuth: basicAuth, anonymousAuth: anonymousAuth, key: key, client: client, debug: debug}
+	return NewClientWithOptions(apiURL, opts...)
 }
 
 func (r *Client) get(ctx context.Context, query string, params url.Values) ([]byte, int, error) {
@@ -84,47 +87,112 @@ func (r *Client) get(ctx context.Context, query string, params url.Values) ([]by
 }
 
 func (r *Client) patch(ctx context.Context, query string, params url.Values, body []byte) ([]byte, int, error) {
-	return r.doRequest(ctx, "PATCH", query, params, bytes.NewBuffer(body))
+	return r.doRequest(ctx, "PATCH", query, params, body)
 }
 
 func (r *Client) put(ctx context.Context, query string, params url.Values, body []byte) ([]byte, int, error) {
-	return r.doRequest(ctx, "PUT", query, params, bytes.NewBuffer(body))
+	return r.doRequest(ctx, "PUT", query, params, body)
 }
 
 func (r *Client) post(ctx context.Context, query string, params url.Values, body []byte) ([]byte, int, error) {
-	return r.doRequest(ctx, "POST", query, params, bytes.NewBuffer(body))
+	return r.doRequest(ctx, "POST", query, params, body)
 }
 
 func (r *Client) delete(ctx context.Context, query string) ([]byte, int, error) {
 	return r.doRequest(ctx, "DELETE", query, nil, nil)
 }
 
-func (r *Client) doRequest(ctx context.Context, method, query string, params url.Values, buf io.Reader) ([]byte, int, error) {
+// doRequest sends the request to Grafana and returns the reply body with
+// the status code. Replies with codes outside of 2xx range are reported
+// as *APIError. Failed requests are repeated according to the retry policy
+// of the client. When the context is done while waiting for the next
+// attempt, the context error is returned.
+func (r *Client) doRequest(ctx context.Context, method, query string, params url.Values, body []byte) ([]byte, int, error) {
 	u, _ := url.Parse(r.baseURL)
-	u.Path = path.Join(u.Path, query)
+	u.Path = path.Join("/", u.Path, query)
 	if params != nil {
 		u.RawQuery = params.Encode()
 	}
+	for attempt := 1; ; attempt++ {
+		data, code, retryAfter, err := r.sendRequest(ctx, method, u, body)
+		if !r.retry.shouldRetry(ctx, attempt, method, code, err) {
+			return data, code, err
+		}
+		timer := time.NewTimer(r.retry.backoff(attempt, retryAfter))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return data, code, fmt.Errorf("%w while waiting to retry: %v", ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
+
+// sendRequest makes a single attempt of the request. The body is wrapped
+// into a new reader for each attempt so it could be safely repeated.
+func (r *Client) sendRequest(ctx context.Context, method string, u *url.URL, body []byte) ([]byte, int, string, error) {
+	if err := r.limiter.Wait(ctx); err != nil {
+		return nil, 0, "", err
+	}
+	var buf io.Reader
+	if body != nil {
+		buf = bytes.NewReader(body)
+	}
 	req, err := http.NewRequest(method, u.String(), buf)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
 	}
 	req = req.WithContext(ctx)
-	if !r.basicAuth {
+	if !r.basicAuth && !r.anonymousAuth {
 		req.Header.Set("Authorization", r.key)
 	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "autograf")
-	if r.debug {
-		dump, _ := httputil.DumpRequestOut(req, true)
-		fmt.Printf("%v\n", string(dump))
+	req.Header.Set("User-Agent", r.userAgent)
+	for k, v := range r.headers {
+		req.Header[k] = append([]string(nil), v...)
+	}
+	if r.orgID != 0 {
+		req.Header.Set("X-Grafana-Org-Id", strconv.FormatUint(uint64(r.orgID), 10))
 	}
+	for _, h := range r.hooks {
+		if h.BeforeRequest != nil {
+			h.BeforeRequest(req, body)
+		}
+	}
+	start := time.Now()
 	resp, err := r.client.Do(req)
 	if err != nil {
-		return nil, 0, err
+		r.onError(req, err, time.Since(start))
+		return nil, 0, "", err
 	}
 	data, err := ioutil.ReadAll(resp.Body)
 	resp.Body.Close()
-	return data, resp.StatusCode, err
+	retryAfter := resp.Header.Get("Retry-After")
+	if err != nil {
+		r.onError(req, err, time.Since(start))
+		return data, resp.StatusCode, retryAfter, err
+	}
+	for _, h := range r.hooks {
+		if h.AfterResponse != nil {
+			h.AfterResponse(req, resp, data, time.Since(start))
+		}
+	}
+	if resp.StatusCode/100 != 2 {
+		return data, resp.StatusCode, retryAfter, newAPIError(method, u.Path, resp.StatusCode, data)
+	}
+	return data, resp.StatusCode, retryAfter, nil
+}
+
+func (r *Client) onError(req *http.Request, err error, elapsed time.Duration) {
+	for _, h := range r.hooks {
+		if h.OnError != nil {
+			h.OnError(req, err, elapsed)
+		}
+	}
 }
@@ -0,0 +1,233 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// https://grafana.com/docs/grafana/latest/developers/http_api/serviceaccount/
+
+// Service accounts appeared in Grafana v9, the calls below return
+// ErrUnsupported for older versions.
+
+// SearchServiceAccounts searches service accounts of the actual organization.
+// Reflects GET /api/serviceaccounts/search API call.
+func (r *Client) SearchServiceAccounts(ctx context.Context, params ...SearchServiceAccountsParams) (PageServiceAccounts, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return PageServiceAccounts{}, err
+	}
+	var (
+		raw  []byte
+		page PageServiceAccounts
+		err  error
+	)
+	requestParams := make(url.Values)
+	for _, p := range params {
+		p(requestParams)
+	}
+	if raw, _, err = r.get(ctx, "api/serviceaccounts/search", requestParams); err != nil {
+		return page, err
+	}
+	err = json.Unmarshal(raw, &page)
+	return page, err
+}
+
+// CreateServiceAccount creates a new service account.
+// Reflects POST /api/serviceaccounts API call.
+func (r *Client) CreateServiceAccount(ctx context.Context, req CreateServiceAccountRequest) (ServiceAccount, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return ServiceAccount{}, err
+	}
+	var (
+		raw []byte
+		sa  ServiceAccount
+		err error
+	)
+	if raw, err = json.Marshal(req); err != nil {
+		return sa, err
+	}
+	if raw, _, err = r.post(ctx, "api/serviceaccounts", nil, raw); err != nil {
+		return sa, err
+	}
+	err = json.Unmarshal(raw, &sa)
+	return sa, err
+}
+
+// GetServiceAccount gets the service account by its id.
+// Reflects GET /api/serviceaccounts/:id API call.
+func (r *Client) GetServiceAccount(ctx context.Context, id uint) (ServiceAccount, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return ServiceAccount{}, err
+	}
+	var (
+		raw []byte
+		sa  ServiceAccount
+		err error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/serviceaccounts/%d", id), nil); err != nil {
+		return sa, err
+	}
+	err = json.Unmarshal(raw, &sa)
+	return sa, err
+}
+
+// UpdateServiceAccount updates the set fields of the service account and
+// returns the updated account.
+// Reflects PATCH /api/serviceaccounts/:id API call.
+func (r *Client) UpdateServiceAccount(ctx context.Context, id uint, req UpdateServiceAccountRequest) (ServiceAccount, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return ServiceAccount{}, err
+	}
+	var (
+		raw  []byte
+		resp struct {
+			ServiceAccount ServiceAccount `json:"serviceaccount"`
+		}
+		err error
+	)
+	if raw, err = json.Marshal(req); err != nil {
+		return resp.ServiceAccount, err
+	}
+	if raw, _, err = r.patch(ctx, fmt.Sprintf("api/serviceaccounts/%d", id), nil, raw); err != nil {
+		return resp.ServiceAccount, err
+	}
+	err = json.Unmarshal(raw, &resp)
+	return resp.ServiceAccount, err
+}
+
+// DeleteServiceAccount deletes the service account with all its tokens.
+// Reflects DELETE /api/serviceaccounts/:id API call.
+func (r *Client) DeleteServiceAccount(ctx context.Context, id uint) (StatusMessage, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return StatusMessage{}, err
+	}
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, _, err = r.delete(ctx, fmt.Sprintf("api/serviceaccounts/%d", id)); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// GetServiceAccountTokens gets the tokens of the service account.
+// Reflects GET /api/serviceaccounts/:id/tokens API call.
+func (r *Client) GetServiceAccountTokens(ctx context.Context, id uint) ([]ServiceAccountToken, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return nil, err
+	}
+	var (
+		raw    []byte
+		tokens []ServiceAccountToken
+		err    error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/serviceaccounts/%d/tokens", id), nil); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, &tokens)
+	return tokens, err
+}
+
+// CreateServiceAccountToken creates a new token for the service account.
+// The key of the token is returned only once, Grafana doesn't keep it.
+// Reflects POST /api/serviceaccounts/:id/tokens API call.
+func (r *Client) CreateServiceAccountToken(ctx context.Context, id uint, req CreateServiceAccountTokenRequest) (NewServiceAccountToken, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return NewServiceAccountToken{}, err
+	}
+	var (
+		raw   []byte
+		token NewServiceAccountToken
+		err   error
+	)
+	if raw, err = json.Marshal(req); err != nil {
+		return token, err
+	}
+	if raw, _, err = r.post(ctx, fmt.Sprintf("api/serviceaccounts/%d/tokens", id), nil, raw); err != nil {
+		return token, err
+	}
+	err = json.Unmarshal(raw, &token)
+	return token, err
+}
+
+// DeleteServiceAccountToken deletes the token of the service account.
+// Reflects DELETE /api/serviceaccounts/:id/tokens/:tokenId API call.
+func (r *Client) DeleteServiceAccountToken(ctx context.Context, id, tokenID uint) (StatusMessage, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return StatusMessage{}, err
+	}
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, _, err = r.delete(ctx, fmt.Sprintf("api/serviceaccounts/%d/tokens/%d", id, tokenID)); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// MigrateAPIKeysToServiceAccounts converts all API keys of the actual
+// organization to service accounts with tokens. The keys keep working
+// as the tokens.
+// Reflects POST /api/serviceaccounts/migrate API call.
+func (r *Client) MigrateAPIKeysToServiceAccounts(ctx context.Context) (ServiceAccountMigrationResult, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return ServiceAccountMigrationResult{}, err
+	}
+	var (
+		raw    []byte
+		result ServiceAccountMigrationResult
+		err    error
+	)
+	if raw, _, err = r.post(ctx, "api/serviceaccounts/migrate", nil, nil); err != nil {
+		return result, err
+	}
+	err = json.Unmarshal(raw, &result)
+	return result, err
+}
+
+// MigrateAPIKeyToServiceAccount converts the API key to a service account.
+// Reflects POST /api/serviceaccounts/migrate/:keyId API call.
+func (r *Client) MigrateAPIKeyToServiceAccount(ctx context.Context, keyID uint) (StatusMessage, error) {
+	if err := r.require(ctx, CapServiceAccounts); err != nil {
+		return StatusMessage{}, err
+	}
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, _, err = r.post(ctx, fmt.Sprintf("api/serviceaccounts/migrate/%d", keyID), nil, nil); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// SearchServiceAccountsParams is the type for all options implementing
+// query parameters of the service accounts search.
+type SearchServiceAccountsParams func(values url.Values)
+
+// ServiceAccountQuery filters service accounts by the name or login.
+func ServiceAccountQuery(query string) SearchServiceAccountsParams {
+	return func(v url.Values) {
+		v.Set("query", query)
+	}
+}
+
+// ServiceAccountPage requests the page of the search result. Pages are
+// numbered from 1.
+func ServiceAccountPage(page, perPage int) SearchServiceAccountsParams {
+	return func(v url.Values) {
+		v.Set("page", strconv.Itoa(page))
+		v.Set("perpage", strconv.Itoa(perPage))
+	}
+}
@@ -0,0 +1,76 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestServiceAccounts(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	sa, err := client.CreateServiceAccount(ctx, sdk.CreateServiceAccountRequest{Name: "team-a", Role: "Editor"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.CreateServiceAccount(ctx, sdk.CreateServiceAccountRequest{Name: "team-b"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.CreateServiceAccount(ctx, sdk.CreateServiceAccountRequest{Name: "team-a"}); !errors.Is(err, sdk.ErrConflict) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+
+	page, err := client.SearchServiceAccounts(ctx, sdk.ServiceAccountQuery("team"), sdk.ServiceAccountPage(2, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.TotalCount != 2 || len(page.ServiceAccounts) != 1 || page.ServiceAccounts[0].Name != "team-b" {
+		t.Errorf("unexpected search result %+v", page)
+	}
+
+	role := "Admin"
+	updated, err := client.UpdateServiceAccount(ctx, sa.ID, sdk.UpdateServiceAccountRequest{Role: &role})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Role != "Admin" || updated.Name != "team-a" {
+		t.Errorf("unexpected updated service account %+v", updated)
+	}
+
+	token, err := client.CreateServiceAccountToken(ctx, sa.ID, sdk.CreateServiceAccountTokenRequest{Name: "ci", SecondsToLive: 3600})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Key == "" {
+		t.Error("expected the key of the created token")
+	}
+	tokens, err := client.GetServiceAccountTokens(ctx, sa.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 1 || tokens[0].Name != "ci" || tokens[0].Expiration == nil || tokens[0].HasExpired {
+		t.Errorf("unexpected tokens %+v", tokens)
+	}
+	if got, err := client.GetServiceAccount(ctx, sa.ID); err != nil || got.Tokens != 1 {
+		t.Errorf("expected one token of the service account, got %+v, %v", got, err)
+	}
+	if _, err = client.DeleteServiceAccountToken(ctx, sa.ID, token.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = client.DeleteServiceAccount(ctx, sa.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.GetServiceAccount(ctx, sa.ID); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if _, err = client.MigrateAPIKeysToServiceAccounts(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
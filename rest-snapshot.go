@@ -4,31 +4,111 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
 
 	"github.com/pkg/errors"
 )
 
 // https://grafana.com/docs/grafana/latest/http_api/snapshot/
 
-// CreateAnnotation creates a new snapshot.
-func (r *Client) CreateSnapshot(ctx context.Context, a CreateSnapshotRequest) (StatusMessage, error) {
+// CreateSnapshot creates a new snapshot.
+// Reflects POST /api/snapshots API call.
+func (r *Client) CreateSnapshot(ctx context.Context, a CreateSnapshotRequest) (SnapshotResponse, error) {
 	var (
 		raw  []byte
-		resp StatusMessage
+		resp SnapshotResponse
 		err  error
-		code int
 	)
 	if raw, err = json.Marshal(a); err != nil {
-		return StatusMessage{}, errors.Wrap(err, "marshal request")
+		return SnapshotResponse{}, errors.Wrap(err, "marshal request")
 	}
-	if raw, code, err = r.post(ctx, "api/snapshots", nil, raw); err != nil {
-		return StatusMessage{}, errors.Wrap(err, "create snapshot")
-	}
-	if code/100 != 2 {
-		return StatusMessage{}, fmt.Errorf("bad response: %d", code)
+	if raw, _, err = r.post(ctx, "api/snapshots", nil, raw); err != nil {
+		return SnapshotResponse{}, errors.Wrap(err, "create snapshot")
 	}
 	if err = json.Unmarshal(raw, &resp); err != nil {
-		return StatusMessage{}, errors.Wrap(err, "unmarshal response message")
+		return SnapshotResponse{}, errors.Wrap(err, "unmarshal response message")
 	}
 	return resp, nil
 }
+
+// ListSnapshots gets snapshots of the actual organization. Empty query
+// and zero limit are not sent.
+// Reflects GET /api/dashboard/snapshots API call.
+func (r *Client) ListSnapshots(ctx context.Context, query string, limit int) ([]Snapshot, error) {
+	var (
+		raw       []byte
+		snapshots []Snapshot
+		params    = make(url.Values)
+		err       error
+	)
+	if query != "" {
+		params.Set("query", query)
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	if raw, _, err = r.get(ctx, "api/dashboard/snapshots", params); err != nil {
+		return nil, errors.Wrap(err, "list snapshots")
+	}
+	if err = json.Unmarshal(raw, &snapshots); err != nil {
+		return nil, errors.Wrap(err, "unmarshal snapshots")
+	}
+	return snapshots, nil
+}
+
+// GetSnapshotByKey gets the dashboard embedded in the snapshot with its
+// metadata.
+// Reflects GET /api/snapshots/:key API call.
+func (r *Client) GetSnapshotByKey(ctx context.Context, key string) (Board, BoardProperties, error) {
+	var (
+		raw    []byte
+		result struct {
+			Meta      BoardProperties `json:"meta"`
+			Dashboard Board           `json:"dashboard"`
+		}
+		err error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/snapshots/%s", key), nil); err != nil {
+		return Board{}, BoardProperties{}, errors.Wrap(err, "get snapshot")
+	}
+	if err = decodeJSON(raw, &result); err != nil {
+		return Board{}, BoardProperties{}, errors.Wrap(err, "unmarshal snapshot")
+	}
+	return result.Dashboard, result.Meta, nil
+}
+
+// DeleteSnapshotByKey deletes the snapshot by its key.
+// Reflects DELETE /api/snapshots/:key API call.
+func (r *Client) DeleteSnapshotByKey(ctx context.Context, key string) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, _, err = r.delete(ctx, fmt.Sprintf("api/snapshots/%s", key)); err != nil {
+		return StatusMessage{}, errors.Wrap(err, "delete snapshot")
+	}
+	if err = json.Unmarshal(raw, &reply); err != nil {
+		return StatusMessage{}, errors.Wrap(err, "unmarshal response message")
+	}
+	return reply, nil
+}
+
+// DeleteSnapshotByDeleteKey deletes the snapshot by the delete key
+// returned on its creation. The call doesn't need authorization.
+// Reflects GET /api/snapshots-delete/:deleteKey API call.
+func (r *Client) DeleteSnapshotByDeleteKey(ctx context.Context, deleteKey string) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/snapshots-delete/%s", deleteKey), nil); err != nil {
+		return StatusMessage{}, errors.Wrap(err, "delete snapshot")
+	}
+	if err = json.Unmarshal(raw, &reply); err != nil {
+		return StatusMessage{}, errors.Wrap(err, "unmarshal response message")
+	}
+	return reply, nil
+}
@@ -0,0 +1,229 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// SnapshotMaxDataPoints limits the number of points of the series queried
+// for the snapshot panels.
+const SnapshotMaxDataPoints = 1000
+
+// EmbedSnapshotData runs targets of the board panels through the datasource
+// query API for the time range and embeds the results into the panels as
+// snapshotData. The board time is set to the range, so the snapshot shows
+// the data as it was in that window.
+//
+// Datasources of the panels and targets are referenced by uid or name,
+// or by reference objects since Grafana 8.3. Grafana before 8.3 gets the
+// datasources of the queries by name, see CapDatasourceRefs. Targets of
+// custom panels like timeseries are sent with all their fields, targets
+// of the other panels are limited by Target fields.
+//
+// Panels without datasource use the default one, targets of the panels
+// with the mixed datasource use their own datasources. Hidden targets are
+// skipped. Template variables are not substituted, so the panels with
+// datasources set by variables like "$ds" or the ones unknown to the
+// server are left without snapshotData.
+func (r *Client) EmbedSnapshotData(ctx context.Context, board *Board, from, to time.Time) error {
+	datasources, err := r.GetAllDatasources(ctx)
+	if err != nil {
+		return fmt.Errorf("get datasources: %w", err)
+	}
+	refs := true
+	if v, err := r.ServerVersion(ctx); err == nil {
+		refs = v.Supports(CapDatasourceRefs)
+	} else if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	var (
+		byUID      = make(map[string]Datasource, len(datasources))
+		byName     = make(map[string]Datasource, len(datasources))
+		def        *Datasource
+		fromMillis = epochMillis(from)
+		toMillis   = epochMillis(to)
+		intervalMs = to.Sub(from).Milliseconds() / SnapshotMaxDataPoints
+	)
+	for i, ds := range datasources {
+		if ds.UID != "" {
+			byUID[ds.UID] = ds
+		}
+		byName[ds.Name] = ds
+		if ds.IsDefault {
+			def = &datasources[i]
+		}
+	}
+	if intervalMs < 1 {
+		intervalMs = 1
+	}
+	// lookup resolves the datasource by uid first as Grafana does
+	lookup := func(ref string) (Datasource, bool) {
+		if ref == "" || ref == "default" || ref == MixedSource {
+			if def == nil {
+				return Datasource{}, false
+			}
+			return *def, true
+		}
+		if ds, ok := byUID[ref]; ok {
+			return ds, true
+		}
+		ds, ok := byName[ref]
+		return ds, ok
+	}
+	embed := func(p *Panel) error {
+		targets, err := panelTargets(p)
+		if err != nil || len(targets) == 0 {
+			return err
+		}
+		var (
+			panelRef string
+			panelOK  = true
+			order    []string
+			groups   = make(map[string][]DatasourceQuery)
+			frames   = make(map[string][]DataFrame)
+		)
+		if p.Datasource != nil {
+			panelRef = *p.Datasource
+		} else if p.CustomPanel != nil {
+			panelRef, panelOK = datasourceRef((*p.CustomPanel)["datasource"])
+		}
+		for _, t := range targets {
+			if t.hide {
+				continue
+			}
+			ref, ok := panelRef, panelOK
+			if (t.ref != "" || !t.refOK) && (panelRef == "" || panelRef == MixedSource) {
+				ref, ok = t.ref, t.refOK
+			}
+			ds, found := lookup(ref)
+			if !ok || !found {
+				// the panel is left without data
+				return nil
+			}
+			q := newDatasourceQuery(t.query, ds)
+			if !refs {
+				q["datasource"] = ds.Name
+			}
+			q["intervalMs"] = intervalMs
+			q["maxDataPoints"] = SnapshotMaxDataPoints
+			if _, ok := groups[ds.Name]; !ok {
+				order = append(order, ds.Name)
+			}
+			groups[ds.Name] = append(groups[ds.Name], q)
+		}
+		// queries of different datasources are sent separately as Grafana
+		// before v9 doesn't support them in the same request
+		for _, name := range order {
+			resp, err := r.QueryDatasources(ctx, DatasourceQueryRequest{From: fromMillis, To: toMillis, Queries: groups[name]})
+			for refID, result := range resp.Results {
+				if result.Error != "" {
+					return fmt.Errorf("panel %q target %s: %s", p.Title, refID, result.Error)
+				}
+				frames[refID] = result.Frames
+			}
+			if err != nil {
+				return fmt.Errorf("panel %q: %w", p.Title, err)
+			}
+		}
+		p.SnapshotData = []DataFrame{}
+		for _, t := range targets {
+			for _, f := range frames[t.refID] {
+				if f.RefID == "" {
+					f.RefID = t.refID
+				}
+				p.SnapshotData = append(p.SnapshotData, f)
+			}
+		}
+		return nil
+	}
+	if err = board.forEachPanel(embed); err != nil {
+		return err
+	}
+	board.Time = Time{From: from.UTC().Format(time.RFC3339), To: to.UTC().Format(time.RFC3339)}
+	return nil
+}
+
+// CreateSnapshotWithData creates the snapshot of the dashboard of the
+// request with the data of the time range embedded in the panels, see
+// EmbedSnapshotData. The panels of the request dashboard are changed.
+func (r *Client) CreateSnapshotWithData(ctx context.Context, req CreateSnapshotRequest, from, to time.Time) (SnapshotResponse, error) {
+	if err := r.EmbedSnapshotData(ctx, &req.Dashboard, from, to); err != nil {
+		return SnapshotResponse{}, err
+	}
+	return r.CreateSnapshot(ctx, req)
+}
+
+// snapshotTarget is the panel target queried for the snapshot.
+type snapshotTarget struct {
+	refID string
+	hide  bool
+	// ref is the uid or name of the target datasource, refOK is false
+	// when the datasource is referenced by an object without uid
+	ref   string
+	refOK bool
+	query map[string]interface{}
+}
+
+// panelTargets returns targets of the panel. Targets of custom panels
+// like timeseries are taken as they are in the panel JSON, so the fields
+// not modelled by Target are kept.
+func panelTargets(p *Panel) ([]snapshotTarget, error) {
+	if targets := p.GetTargets(); targets != nil {
+		out := make([]snapshotTarget, 0, len(*targets))
+		for _, t := range *targets {
+			var (
+				query map[string]interface{}
+				raw   []byte
+				err   error
+			)
+			if raw, err = json.Marshal(t); err != nil {
+				return nil, err
+			}
+			if err = decodeJSON(raw, &query); err != nil {
+				return nil, err
+			}
+			out = append(out, snapshotTarget{refID: t.RefID, hide: t.Hide, ref: t.Datasource, refOK: true, query: query})
+		}
+		return out, nil
+	}
+	if p.CustomPanel == nil {
+		return nil, nil
+	}
+	list, _ := (*p.CustomPanel)["targets"].([]interface{})
+	out := make([]snapshotTarget, 0, len(list))
+	for _, item := range list {
+		query, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		t := snapshotTarget{query: query}
+		t.refID, _ = query["refId"].(string)
+		t.hide, _ = query["hide"].(bool)
+		t.ref, t.refOK = datasourceRef(query["datasource"])
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// datasourceRef returns the uid or name of the datasource referenced by
+// the name or by the object with uid and type. It reports false for
+// the objects without uid.
+func datasourceRef(v interface{}) (string, bool) {
+	switch ds := v.(type) {
+	case nil:
+		return "", true
+	case string:
+		return ds, true
+	case map[string]interface{}:
+		uid, _ := ds["uid"].(string)
+		return uid, uid != ""
+	}
+	return "", false
+}
+
+func epochMillis(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
@@ -0,0 +1,171 @@
+package sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestDataFrame_UnmarshalJSON(t *testing.T) {
+	var frames []sdk.DataFrame
+	raw := `[
+		{"schema":{"name":"up","refId":"A","fields":[{"name":"Time","type":"time"},{"name":"Value","type":"number"}]},"data":{"values":[[1000,2000],[1,0]]}},
+		{"name":"up","refId":"B","fields":[{"name":"Value","type":"number","values":[3]}]}
+	]`
+	if err := json.Unmarshal([]byte(raw), &frames); err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 2 || frames[0].RefID != "A" || len(frames[0].Fields) != 2 || len(frames[0].Fields[1].Values) != 2 {
+		t.Fatalf("unexpected frames %+v", frames)
+	}
+	if frames[1].RefID != "B" || frames[1].Fields[0].Values[0] != json.Number("3") {
+		t.Errorf("unexpected frame %+v", frames[1])
+	}
+}
+
+func TestCreateSnapshotWithData(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	for _, ds := range []sdk.Datasource{
+		{Name: "prom", Type: "prometheus", IsDefault: true},
+		{Name: "loki", UID: "logs", Type: "loki"},
+	} {
+		if _, err := client.CreateDatasource(ctx, ds); err != nil {
+			t.Fatal(err)
+		}
+	}
+	board := sdk.NewBoard("Incident")
+	graph := sdk.NewGraph("Errors")
+	graph.AddTarget(&sdk.Target{RefID: "A", Expr: "rate(errors[5m])"})
+	graph.AddTarget(&sdk.Target{RefID: "B", Expr: "hidden", Hide: true})
+	mixed := sdk.NewGraph("Mixed")
+	mixedSource := sdk.MixedSource
+	mixed.Datasource = &mixedSource
+	mixed.AddTarget(&sdk.Target{RefID: "A", Expr: "up"})
+	mixed.AddTarget(&sdk.Target{RefID: "B", Datasource: "loki", Expr: `{app="api"}`})
+	byUID := sdk.NewGraph("Logs")
+	logs := "logs"
+	byUID.Datasource = &logs
+	byUID.AddTarget(&sdk.Target{RefID: "A", Expr: `{app="db"}`})
+	templated := sdk.NewGraph("Templated")
+	variable := "${DS_PROM}"
+	templated.Datasource = &variable
+	templated.AddTarget(&sdk.Target{RefID: "A", Expr: "up"})
+	board.Panels = append(board.Panels, graph, mixed, byUID, templated)
+
+	from := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	resp, err := client.CreateSnapshotWithData(ctx, sdk.CreateSnapshotRequest{Dashboard: *board, Name: "incident"}, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, _, err := client.GetSnapshotByKey(ctx, resp.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Time.From != "2024-03-01T10:00:00Z" || loaded.Time.To != "2024-03-01T11:00:00Z" {
+		t.Errorf("unexpected snapshot time %+v", loaded.Time)
+	}
+	if len(loaded.Panels) != 4 {
+		t.Fatalf("unexpected panels %+v", loaded.Panels)
+	}
+	data := loaded.Panels[0].SnapshotData
+	if len(data) != 1 || data[0].RefID != "A" || data[0].Name != "rate(errors[5m])" ||
+		data[0].Fields[0].Values[0] != json.Number("1709287200000") {
+		t.Errorf("unexpected snapshot data %+v", data)
+	}
+	data = loaded.Panels[1].SnapshotData
+	if len(data) != 2 || data[0].Fields[1].Labels["datasource"] != "prom" || data[1].Fields[1].Labels["datasource"] != "loki" {
+		t.Errorf("unexpected mixed snapshot data %+v", data)
+	}
+	if data = loaded.Panels[2].SnapshotData; len(data) != 1 || data[0].Fields[1].Labels["datasource"] != "loki" {
+		t.Errorf("unexpected snapshot data of the datasource referenced by uid %+v", data)
+	}
+	if data = loaded.Panels[3].SnapshotData; data != nil {
+		t.Errorf("expected no snapshot data for the templated datasource, got %+v", data)
+	}
+}
+
+func TestEmbedSnapshotData_DatasourceRefs(t *testing.T) {
+	for _, version := range []string{sdktest.DefaultVersion, sdktest.LegacyVersion} {
+		srv := sdktest.NewServer(t)
+		srv.Version = version
+		ctx := context.Background()
+		var queries []string
+		client := sdk.NewClientWithOptions(srv.URL,
+			sdk.WithBasicAuth(sdktest.AdminLogin, sdktest.AdminPassword),
+			sdk.WithHooks(sdk.Hooks{BeforeRequest: func(req *http.Request, body []byte) {
+				if req.URL.Path == "/api/ds/query" {
+					queries = append(queries, string(body))
+				}
+			}}))
+		for _, ds := range []sdk.Datasource{
+			{Name: "prom", UID: "metrics", Type: "prometheus", IsDefault: true},
+			{Name: "loki", UID: "logs", Type: "loki"},
+		} {
+			if _, err := client.CreateDatasource(ctx, ds); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var board sdk.Board
+		if err := json.Unmarshal([]byte(`{"title":"Incident","panels":[
+			{"type":"timeseries","title":"Logs","datasource":{"type":"loki","uid":"logs"},
+			 "targets":[{"refId":"A","datasource":{"type":"loki","uid":"logs"},"expr":"{app=\"api\"}","queryType":"range","editorMode":"code"}]},
+			{"type":"timeseries","title":"Templated","datasource":{"type":"prometheus","uid":"${DS_PROM}"},
+			 "targets":[{"refId":"A","expr":"up"}]},
+			{"type":"timeseries","title":"Mixed","datasource":{"type":"datasource","uid":"-- Mixed --"},
+			 "targets":[{"refId":"A","datasource":{"type":"prometheus","uid":"metrics"},"expr":"up","exemplar":true},
+			            {"refId":"B","datasource":{"type":"loki","uid":"logs"},"expr":"{app=\"db\"}"}]}
+		]}`), &board); err != nil {
+			t.Fatal(err)
+		}
+		from := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+		if err := client.EmbedSnapshotData(ctx, &board, from, from.Add(time.Hour)); err != nil {
+			t.Fatalf("%s: %s", version, err)
+		}
+		if data := board.Panels[0].SnapshotData; len(data) != 1 || data[0].Fields[1].Labels["datasource"] != "loki" {
+			t.Errorf("%s: unexpected snapshot data %+v", version, data)
+		}
+		if data := board.Panels[1].SnapshotData; data != nil {
+			t.Errorf("%s: expected no snapshot data for the templated datasource, got %+v", version, data)
+		}
+		if data := board.Panels[2].SnapshotData; len(data) != 2 || data[0].Fields[1].Labels["datasource"] != "prom" || data[1].Fields[1].Labels["datasource"] != "loki" {
+			t.Errorf("%s: unexpected mixed snapshot data %+v", version, data)
+		}
+		all := strings.Join(queries, "\n")
+		for _, field := range []string{`"editorMode":"code"`, `"queryType":"range"`, `"exemplar":true`} {
+			if !strings.Contains(all, field) {
+				t.Errorf("%s: expected %s kept in the queries:\n%s", version, field, all)
+			}
+		}
+		legacyRef := strings.Contains(all, `"datasource":"loki"`)
+		if legacyRef != (version == sdktest.LegacyVersion) {
+			t.Errorf("%s: unexpected datasource references in the queries:\n%s", version, all)
+		}
+		srv.Close()
+	}
+}
+
+func TestQueryDatasources_Error(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	resp, err := srv.Client.QueryDatasources(context.Background(), sdk.DatasourceQueryRequest{
+		From:    "now-1h",
+		To:      "now",
+		Queries: []sdk.DatasourceQuery{{"refId": "A", "datasource": map[string]string{"uid": "missing"}}},
+	})
+	var apiErr *sdk.APIError
+	if !errors.As(err, &apiErr) || resp.Results["A"].Error == "" {
+		t.Errorf("expected query error in results, got %+v: %v", resp, err)
+	}
+}
@@ -33,7 +33,7 @@ func Test_Snapshot_Create(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if !strings.HasPrefix(*resp.URL, "http") {
-		t.Fatalf("bad url: %s", *resp.URL)
+	if !strings.HasPrefix(resp.URL, "http") {
+		t.Fatalf("bad url: %s", resp.URL)
 	}
 }
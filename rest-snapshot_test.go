@@ -0,0 +1,61 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestSnapshots(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	board := sdk.NewBoard("Incident 42")
+	first, err := client.CreateSnapshot(ctx, sdk.CreateSnapshotRequest{Dashboard: *board, Name: "incident-42", Expires: 3600})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Key == "" || first.DeleteKey == "" || first.URL == "" || first.DeleteURL == "" {
+		t.Fatalf("unexpected snapshot response %+v", first)
+	}
+	second, err := client.CreateSnapshot(ctx, sdk.CreateSnapshotRequest{Dashboard: *board, Name: "incident-43", Key: "own-key", DeleteKey: "own-delete-key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Key != "own-key" || second.DeleteKey != "own-delete-key" {
+		t.Errorf("expected own keys, got %+v", second)
+	}
+
+	list, err := client.ListSnapshots(ctx, "incident", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Name != "incident-42" || list[0].Expires.IsZero() || !list[1].Expires.IsZero() {
+		t.Errorf("unexpected snapshots %+v", list)
+	}
+	loaded, meta, err := client.GetSnapshotByKey(ctx, first.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Title != "Incident 42" || !meta.IsSnapshot {
+		t.Errorf("unexpected snapshot %q with meta %+v", loaded.Title, meta)
+	}
+
+	if _, err = client.DeleteSnapshotByKey(ctx, first.Key); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.DeleteSnapshotByDeleteKey(ctx, second.DeleteKey); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = client.GetSnapshotByKey(ctx, second.Key); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found after deletion, got %v", err)
+	}
+	if list, err = client.ListSnapshots(ctx, "", 0); err != nil || len(list) != 0 {
+		t.Errorf("expected no snapshots, got %+v: %v", list, err)
+	}
+}
@@ -0,0 +1,196 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// https://grafana.com/docs/grafana/latest/developers/http_api/team/
+
+// SearchTeams searches teams of the actual organization.
+// Reflects GET /api/teams/search API call.
+func (r *Client) SearchTeams(ctx context.Context, params ...SearchTeamsParams) (PageTeams, error) {
+	var (
+		raw  []byte
+		page PageTeams
+		err  error
+	)
+	requestParams := make(url.Values)
+	for _, p := range params {
+		p(requestParams)
+	}
+	if raw, _, err = r.get(ctx, "api/teams/search", requestParams); err != nil {
+		return page, err
+	}
+	err = json.Unmarshal(raw, &page)
+	return page, err
+}
+
+// GetTeam gets the team by its id.
+// Reflects GET /api/teams/:id API call.
+func (r *Client) GetTeam(ctx context.Context, id uint) (Team, error) {
+	var (
+		raw  []byte
+		team Team
+		err  error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/teams/%d", id), nil); err != nil {
+		return team, err
+	}
+	err = json.Unmarshal(raw, &team)
+	return team, err
+}
+
+// CreateTeam creates a new team in the actual organization and returns
+// its id.
+// Reflects POST /api/teams API call.
+func (r *Client) CreateTeam(ctx context.Context, req TeamRequest) (uint, error) {
+	var (
+		raw   []byte
+		reply struct {
+			TeamID uint `json:"teamId"`
+		}
+		err error
+	)
+	if raw, err = json.Marshal(req); err != nil {
+		return 0, err
+	}
+	if raw, _, err = r.post(ctx, "api/teams", nil, raw); err != nil {
+		return 0, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply.TeamID, err
+}
+
+// UpdateTeam updates the name and email of the team.
+// Reflects PUT /api/teams/:id API call.
+func (r *Client) UpdateTeam(ctx context.Context, id uint, req TeamRequest) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, err = json.Marshal(req); err != nil {
+		return reply, err
+	}
+	if raw, _, err = r.put(ctx, fmt.Sprintf("api/teams/%d", id), nil, raw); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// DeleteTeam deletes the team. Permissions granted to the team are
+// deleted with it.
+// Reflects DELETE /api/teams/:id API call.
+func (r *Client) DeleteTeam(ctx context.Context, id uint) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, _, err = r.delete(ctx, fmt.Sprintf("api/teams/%d", id)); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// GetTeamMembers gets the members of the team.
+// Reflects GET /api/teams/:id/members API call.
+func (r *Client) GetTeamMembers(ctx context.Context, id uint) ([]TeamMember, error) {
+	var (
+		raw     []byte
+		members []TeamMember
+		err     error
+	)
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/teams/%d/members", id), nil); err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(raw, &members)
+	return members, err
+}
+
+// AddTeamMember adds the user to the team.
+// Reflects POST /api/teams/:id/members API call.
+func (r *Client) AddTeamMember(ctx context.Context, id, userID uint) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, err = json.Marshal(struct {
+		UserID uint `json:"userId"`
+	}{userID}); err != nil {
+		return reply, err
+	}
+	if raw, _, err = r.post(ctx, fmt.Sprintf("api/teams/%d/members", id), nil, raw); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// RemoveTeamMember removes the user from the team.
+// Reflects DELETE /api/teams/:id/members/:userId API call.
+func (r *Client) RemoveTeamMember(ctx context.Context, id, userID uint) (StatusMessage, error) {
+	var (
+		raw   []byte
+		reply StatusMessage
+		err   error
+	)
+	if raw, _, err = r.delete(ctx, fmt.Sprintf("api/teams/%d/members/%d", id, userID)); err != nil {
+		return reply, err
+	}
+	err = json.Unmarshal(raw, &reply)
+	return reply, err
+}
+
+// GetTeamPreferences gets preferences of the team.
+// Reflects GET /api/teams/:id/preferences API call.
+func (r *Client) GetTeamPreferences(ctx context.Context, id uint) (Preferences, error) {
+	return r.getPreferences(ctx, fmt.Sprintf("api/teams/%d/preferences", id))
+}
+
+// UpdateTeamPreferences replaces preferences of the team, the preferences
+// not set are reset to the inherited ones.
+// Reflects PUT /api/teams/:id/preferences API call.
+func (r *Client) UpdateTeamPreferences(ctx context.Context, id uint, prefs Preferences) (StatusMessage, error) {
+	return r.updatePreferences(ctx, fmt.Sprintf("api/teams/%d/preferences", id), prefs)
+}
+
+// PatchTeamPreferences changes only the set preferences of the team.
+// Reflects PATCH /api/teams/:id/preferences API call.
+func (r *Client) PatchTeamPreferences(ctx context.Context, id uint, prefs PatchPreferences) (StatusMessage, error) {
+	return r.patchPreferences(ctx, fmt.Sprintf("api/teams/%d/preferences", id), prefs)
+}
+
+// SearchTeamsParams is the type for all options implementing query
+// parameters of the teams search.
+type SearchTeamsParams func(values url.Values)
+
+// TeamQuery filters teams by the name.
+func TeamQuery(query string) SearchTeamsParams {
+	return func(v url.Values) {
+		v.Set("query", query)
+	}
+}
+
+// TeamName selects the team with exactly this name.
+func TeamName(name string) SearchTeamsParams {
+	return func(v url.Values) {
+		v.Set("name", name)
+	}
+}
+
+// TeamPage requests the page of the search result. Pages are numbered
+// from 1.
+func TeamPage(page, perPage int) SearchTeamsParams {
+	return func(v url.Values) {
+		v.Set("page", strconv.Itoa(page))
+		v.Set("perpage", strconv.Itoa(perPage))
+	}
+}
@@ -0,0 +1,114 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestTeams(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	id, err := client.CreateTeam(ctx, sdk.TeamRequest{Name: "ops", Email: "ops@localhost"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.CreateTeam(ctx, sdk.TeamRequest{Name: "dev"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.CreateTeam(ctx, sdk.TeamRequest{Name: "ops"}); !errors.Is(err, sdk.ErrConflict) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+	page, err := client.SearchTeams(ctx, sdk.TeamPage(2, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.TotalCount != 2 || len(page.Teams) != 1 || page.Teams[0].Name != "ops" {
+		t.Errorf("unexpected search result %+v", page)
+	}
+	if page, err = client.SearchTeams(ctx, sdk.TeamName("dev")); err != nil {
+		t.Fatal(err)
+	}
+	if page.TotalCount != 1 || page.Teams[0].Name != "dev" {
+		t.Errorf("unexpected search result %+v", page)
+	}
+
+	user, err := client.CreateUser(ctx, sdk.User{Login: "oncall", Email: "oncall@localhost", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.AddTeamMember(ctx, id, *user.ID); err != nil {
+		t.Fatal(err)
+	}
+	members, err := client.GetTeamMembers(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 1 || members[0].Login != "oncall" || members[0].TeamID != id {
+		t.Errorf("unexpected members %+v", members)
+	}
+	if _, err = client.UpdateTeam(ctx, id, sdk.TeamRequest{Name: "sre", Email: "sre@localhost"}); err != nil {
+		t.Fatal(err)
+	}
+	team, err := client.GetTeam(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if team.Name != "sre" || team.Email != "sre@localhost" || team.MemberCount != 1 {
+		t.Errorf("unexpected team %+v", team)
+	}
+	if _, err = client.RemoveTeamMember(ctx, id, *user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.RemoveTeamMember(ctx, id, *user.ID); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	if _, err = client.UpdateTeamPreferences(ctx, id, sdk.Preferences{Theme: "dark", Timezone: "utc"}); err != nil {
+		t.Fatal(err)
+	}
+	prefs, err := client.GetTeamPreferences(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prefs.Theme != "dark" || prefs.Timezone != "utc" {
+		t.Errorf("unexpected preferences %+v", prefs)
+	}
+
+	folder, err := client.CreateFolder(ctx, sdk.Folder{Title: "SRE"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	acl := []sdk.PermissionItem{team.Grant(sdk.PermissionEdit)}
+	if _, err = client.UpdateFolderPermissions(ctx, folder.UID, acl); err != nil {
+		t.Fatal(err)
+	}
+	if acl, err = client.GetFolderPermissions(ctx, folder.UID); err != nil {
+		t.Fatal(err)
+	}
+	if len(acl) != 1 || acl[0].TeamID != id || acl[0].Team != "sre" || acl[0].TeamEmail != "sre@localhost" {
+		t.Errorf("unexpected folder ACL %+v", acl)
+	}
+	if _, err = client.UpdateFolderPermissions(ctx, folder.UID, []sdk.PermissionItem{sdk.TeamPermission(id+100, sdk.PermissionView)}); err == nil {
+		t.Error("expected error for the unknown team")
+	}
+
+	if _, err = client.DeleteTeam(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.GetTeam(ctx, id); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+	if acl, err = client.GetFolderPermissions(ctx, folder.UID); err != nil {
+		t.Fatal(err)
+	}
+	if len(acl) != 0 {
+		t.Errorf("expected the team permission deleted, got %+v", acl)
+	}
+}
@@ -33,15 +33,11 @@ func (r *Client) GetActualUser(ctx context.Context) (User, error) {
 	var (
 		raw  []byte
 		user User
-		code int
 		err  error
 	)
-	if raw, code, err = r.get(ctx, "api/user", nil); err != nil {
+	if raw, _, err = r.get(ctx, "api/user", nil); err != nil {
 		return user, err
 	}
-	if code != 200 {
-		return user, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&user); err != nil {
@@ -56,15 +52,11 @@ func (r *Client) GetUser(ctx context.Context, id uint) (User, error) {
 	var (
 		raw  []byte
 		user User
-		code int
 		err  error
 	)
-	if raw, code, err = r.get(ctx, fmt.Sprintf("api/users/%d", id), nil); err != nil {
+	if raw, _, err = r.get(ctx, fmt.Sprintf("api/users/%d", id), nil); err != nil {
 		return user, err
 	}
-	if code != 200 {
-		return user, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&user); err != nil {
@@ -79,18 +71,14 @@ func (r *Client) GetAllUsers(ctx context.Context) ([]User, error) {
 	var (
 		raw   []byte
 		users []User
-		code  int
 		err   error
 	)
 
 	params := url.Values{}
 	params.Set("perpage", "99999")
-	if raw, code, err = r.get(ctx, "api/users", params); err != nil {
+	if raw, _, err = r.get(ctx, "api/users", params); err != nil {
 		return users, err
 	}
-	if code != 200 {
-		return users, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&users); err != nil {
@@ -111,7 +99,6 @@ func (r *Client) SearchUsersWithPaging(ctx context.Context, query *string, perpa
 	var (
 		raw       []byte
 		pageUsers PageUsers
-		code      int
 		err       error
 	)
 
@@ -131,12 +118,9 @@ func (r *Client) SearchUsersWithPaging(ctx context.Context, query *string, perpa
 		params["query"] = []string{*query}
 	}
 
-	if raw, code, err = r.get(ctx, "api/users/search", params); err != nil {
+	if raw, _, err = r.get(ctx, "api/users/search", params); err != nil {
 		return pageUsers, err
 	}
-	if code != 200 {
-		return pageUsers, fmt.Errorf("HTTP error %d: returns %s", code, raw)
-	}
 	dec := json.NewDecoder(bytes.NewReader(raw))
 	dec.UseNumber()
 	if err := dec.Decode(&pageUsers); err != nil {
@@ -147,6 +131,8 @@ func (r *Client) SearchUsersWithPaging(ctx context.Context, query *string, perpa
 
 // SwitchActualUserContext switches current user context to the given organization.
 // Reflects POST /api/user/using/:organizationId API call.
+// Consider Client.WithOrg() for scoping requests to an organization without
+// changing the server side state.
 func (r *Client) SwitchActualUserContext(ctx context.Context, oid uint) (StatusMessage, error) {
 	var (
 		raw  []byte
@@ -162,3 +148,75 @@ func (r *Client) SwitchActualUserContext(ctx context.Context, oid uint) (StatusM
 	}
 	return resp, nil
 }
+
+// StarDashboard stars the dashboard for the current user. Recent Grafana
+// versions deprecate the call by id, use StarDashboardByUID with them.
+// Reflects POST /api/user/stars/dashboard/:dashboardId API call.
+func (r *Client) StarDashboard(ctx context.Context, dashboardID uint) (StatusMessage, error) {
+	var (
+		raw  []byte
+		resp StatusMessage
+		err  error
+	)
+	if raw, _, err = r.post(ctx, fmt.Sprintf("api/user/stars/dashboard/%d", dashboardID), nil, nil); err != nil {
+		return StatusMessage{}, err
+	}
+	if err = json.Unmarshal(raw, &resp); err != nil {
+		return StatusMessage{}, err
+	}
+	return resp, nil
+}
+
+// UnstarDashboard removes the star of the current user from the dashboard.
+// Recent Grafana versions deprecate the call by id, use
+// UnstarDashboardByUID with them.
+// Reflects DELETE /api/user/stars/dashboard/:dashboardId API call.
+func (r *Client) UnstarDashboard(ctx context.Context, dashboardID uint) (StatusMessage, error) {
+	var (
+		raw  []byte
+		resp StatusMessage
+		err  error
+	)
+	if raw, _, err = r.delete(ctx, fmt.Sprintf("api/user/stars/dashboard/%d", dashboardID)); err != nil {
+		return StatusMessage{}, err
+	}
+	if err = json.Unmarshal(raw, &resp); err != nil {
+		return StatusMessage{}, err
+	}
+	return resp, nil
+}
+
+// StarDashboardByUID stars the dashboard for the current user.
+// Reflects POST /api/user/stars/dashboard/uid/:uid API call.
+func (r *Client) StarDashboardByUID(ctx context.Context, uid string) (StatusMessage, error) {
+	var (
+		raw  []byte
+		resp StatusMessage
+		err  error
+	)
+	if raw, _, err = r.post(ctx, fmt.Sprintf("api/user/stars/dashboard/uid/%s", uid), nil, nil); err != nil {
+		return StatusMessage{}, err
+	}
+	if err = json.Unmarshal(raw, &resp); err != nil {
+		return StatusMessage{}, err
+	}
+	return resp, nil
+}
+
+// UnstarDashboardByUID removes the star of the current user from the
+// dashboard.
+// Reflects DELETE /api/user/stars/dashboard/uid/:uid API call.
+func (r *Client) UnstarDashboardByUID(ctx context.Context, uid string) (StatusMessage, error) {
+	var (
+		raw  []byte
+		resp StatusMessage
+		err  error
+	)
+	if raw, _, err = r.delete(ctx, fmt.Sprintf("api/user/stars/dashboard/uid/%s", uid)); err != nil {
+		return StatusMessage{}, err
+	}
+	if err = json.Unmarshal(raw, &resp); err != nil {
+		return StatusMessage{}, err
+	}
+	return resp, nil
+}
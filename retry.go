@@ -0,0 +1,121 @@
+package sdk
+
+/*
+   Copyright 2016 Alexander I.Grafov <grafov@gmail.com>
+   Copyright 2016-2019 The Grafana SDK authors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+	   http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+
+   ॐ तारे तुत्तारे तुरे स्व
+*/
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy defines how the client repeats requests failed because of
+// network errors or temporary unavailability of Grafana (429, 502, 503
+// and 504 replies). The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry. It doubles with
+	// each next attempt but never exceeds MaxBackoff. Random jitter
+	// is applied to the delay. Retry-After header of the reply is
+	// honored when it asks to wait longer.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RetryPost enables retries of POST and PATCH requests. They are not
+	// idempotent so only GET, HEAD, OPTIONS, PUT and DELETE requests
+	// are retried by default.
+	RetryPost bool
+}
+
+// DefaultRetryPolicy is the reasonable policy for the long running
+// operations like backups. It is not applied by NewClient, use
+// SetRetryPolicy for enabling it.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+// SetRetryPolicy sets the policy for repeating of failed requests.
+func (r *Client) SetRetryPolicy(policy RetryPolicy) {
+	r.retry = policy
+}
+
+// shouldRetry decides whether the request should be repeated after the attempt
+// finished with the code and err.
+func (p RetryPolicy) shouldRetry(ctx context.Context, attempt int, method string, code int, err error) bool {
+	if err == nil || attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		if !p.RetryPost {
+			return false
+		}
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		// transport level errors are always temporary
+		return true
+	}
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff calculates the delay before the next attempt. The value of
+// Retry-After header is used if it asks to wait longer.
+func (p RetryPolicy) backoff(attempt int, retryAfter string) time.Duration {
+	delay := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay > 0 {
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+	}
+	if wait := parseRetryAfter(retryAfter); wait > delay {
+		delay = wait
+	}
+	return delay
+}
+
+// parseRetryAfter understands both forms of Retry-After header:
+// delay in seconds and HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
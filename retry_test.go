@@ -0,0 +1,116 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bdunavant/sdk"
+)
+
+var testRetryPolicy = sdk.RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+	client := sdk.NewClient(ts.URL, "", ts.Client(), false)
+	client.SetRetryPolicy(testRetryPolicy)
+	if _, err := client.Search(context.Background()); err != nil {
+		t.Fatalf("expected request to succeed after retries, got %s", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_RetryPolicyExhausted(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+	client := sdk.NewClient(ts.URL, "", ts.Client(), false)
+	client.SetRetryPolicy(testRetryPolicy)
+	_, err := client.Search(context.Background())
+	var apiErr *sdk.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected bad gateway error, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClient_RetryPolicyPost(t *testing.T) {
+	var (
+		attempts int32
+		bodies   = make(chan string, 3)
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies <- string(body)
+		if atomic.AddInt32(&attempts, 1) < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":1}`))
+	}))
+	defer ts.Close()
+	client := sdk.NewClient(ts.URL, "", ts.Client(), false)
+	client.SetRetryPolicy(testRetryPolicy)
+	if _, err := client.CreateFolder(context.Background(), sdk.Folder{Title: "retry"}); err == nil {
+		t.Fatal("expected POST request not to be retried by default")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+
+	atomic.StoreInt32(&attempts, 0)
+	<-bodies
+	policy := testRetryPolicy
+	policy.RetryPost = true
+	client.SetRetryPolicy(policy)
+	if _, err := client.CreateFolder(context.Background(), sdk.Folder{Title: "retry"}); err != nil {
+		t.Fatalf("expected POST request to succeed after retries, got %s", err)
+	}
+	if first, second := <-bodies, <-bodies; first != second || first == "" {
+		t.Errorf("expected the same body for each attempt, got %q and %q", first, second)
+	}
+}
+
+func TestClient_RetryPolicyContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+	client := sdk.NewClient(ts.URL, "", ts.Client(), false)
+	client.SetRetryPolicy(testRetryPolicy)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := client.Search(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the retry to stop on context cancellation, it took %s", elapsed)
+	}
+}
@@ -0,0 +1,205 @@
+package sdktest
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/bdunavant/sdk"
+)
+
+// openSession records the login session of the user authenticated by
+// the request unless the user already has an active one. Grafana opens
+// sessions on login to UI, the fake opens them on the first request.
+func (s *Server) openSession(u *user, r *http.Request) {
+	now := time.Now().UTC()
+	for _, t := range u.sessions {
+		if t.IsActive {
+			t.SeenAt = now
+			return
+		}
+	}
+	if u.sessions == nil {
+		u.sessions = make(map[uint]*sdk.UserAuthToken)
+	}
+	ip, _, _ := net.SplitHostPort(r.RemoteAddr)
+	id := s.nextID("session")
+	u.sessions[id] = &sdk.UserAuthToken{
+		ID:        id,
+		IsActive:  true,
+		ClientIP:  ip,
+		Browser:   "Other",
+		OS:        "Other",
+		Device:    "Other",
+		CreatedAt: now,
+		SeenAt:    now,
+	}
+}
+
+func (s *Server) getSettings(r *request) (int, interface{}) {
+	u, _ := url.Parse(s.URL)
+	return http.StatusOK, sdk.AdminSettings{
+		"server": {
+			"http_port": u.Port(),
+			"root_url":  s.URL + "/",
+		},
+		"security": {
+			"admin_user":     AdminLogin,
+			"admin_password": "************",
+		},
+		"database": {
+			"type": "sqlite3",
+		},
+		"users": {
+			"allow_sign_up": "false",
+		},
+	}
+}
+
+func (s *Server) getStats(r *request) (int, interface{}) {
+	stats := sdk.AdminStats{Orgs: int64(len(s.orgs)), Users: int64(len(s.users))}
+	for _, u := range s.users {
+		if u.IsGrafanaAdmin {
+			stats.Admins++
+		}
+		for _, t := range u.sessions {
+			if t.IsActive {
+				stats.ActiveSessions++
+			}
+		}
+	}
+	for _, o := range s.orgs {
+		tags := make(map[string]bool)
+		for _, d := range o.dashboards {
+			stats.Dashboards++
+			stats.Stars += int64(len(d.starredBy))
+			for _, tag := range d.tags {
+				tags[tag] = true
+			}
+		}
+		stats.Tags += int64(len(tags))
+		stats.Snapshots += int64(len(o.snapshots))
+		stats.Datasources += int64(len(o.datasources))
+		stats.Playlists += int64(len(o.playlists))
+		for _, role := range o.users {
+			switch role {
+			case "Editor":
+				stats.Editors++
+			case "Viewer":
+				stats.Viewers++
+			}
+		}
+	}
+	return http.StatusOK, stats
+}
+
+func (s *Server) updateUserPassword(r *request) (int, interface{}) {
+	var in struct {
+		Password string `json:"password"`
+	}
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	if len(in.Password) < 4 {
+		return http.StatusBadRequest, message("New password too short")
+	}
+	u.password = in.Password
+	return http.StatusOK, message("User password updated")
+}
+
+func (s *Server) deleteUser(r *request) (int, interface{}) {
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	delete(s.users, u.ID)
+	for _, o := range s.orgs {
+		delete(o.users, u.ID)
+		delete(o.userPrefs, u.ID)
+		for _, t := range o.teams {
+			delete(t.members, u.ID)
+		}
+	}
+	return http.StatusOK, message("User deleted")
+}
+
+func (s *Server) disableUser(r *request) (int, interface{}) {
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	u.IsDisabled = true
+	u.sessions = nil
+	return http.StatusOK, message("User disabled")
+}
+
+func (s *Server) enableUser(r *request) (int, interface{}) {
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	u.IsDisabled = false
+	return http.StatusOK, message("User enabled")
+}
+
+func (s *Server) getUserAuthTokens(r *request) (int, interface{}) {
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	tokens := make([]sdk.UserAuthToken, 0, len(u.sessions))
+	for _, t := range u.sessions {
+		tokens = append(tokens, *t)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].ID < tokens[j].ID })
+	return http.StatusOK, tokens
+}
+
+func (s *Server) revokeUserAuthToken(r *request) (int, interface{}) {
+	var in struct {
+		AuthTokenID uint `json:"authTokenId"`
+	}
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	if u.sessions[in.AuthTokenID] == nil {
+		return http.StatusNotFound, message("User auth token not found")
+	}
+	delete(u.sessions, in.AuthTokenID)
+	return http.StatusOK, message("User auth token revoked")
+}
+
+func (s *Server) logoutUser(r *request) (int, interface{}) {
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	u.sessions = nil
+	return http.StatusOK, message("User logged out")
+}
+
+// reloadProvisioning answers as Grafana does when the configuration is
+// reloaded, nothing is provisioned by the fake.
+func (s *Server) reloadProvisioning(r *request) (int, interface{}) {
+	switch sdk.ProvisionedType(r.params["kind"]) {
+	case sdk.ProvisionedDashboards:
+		return http.StatusOK, message("Dashboards config reloaded")
+	case sdk.ProvisionedDatasources:
+		return http.StatusOK, message("Datasources config reloaded")
+	case sdk.ProvisionedNotifications:
+		return http.StatusOK, message("Notifications config reloaded")
+	case sdk.ProvisionedPlugins:
+		return http.StatusOK, message("Plugins config reloaded")
+	}
+	return http.StatusNotFound, message("Not found")
+}
@@ -0,0 +1,119 @@
+package sdktest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/bdunavant/sdk"
+)
+
+func (o *org) alertNotificationByUID(uid string) *sdk.AlertNotification {
+	for _, an := range o.alertNotifications {
+		if an.UID == uid {
+			return an
+		}
+	}
+	return nil
+}
+
+func (o *org) alertNotificationByName(name string) *sdk.AlertNotification {
+	for _, an := range o.alertNotifications {
+		if an.Name == name {
+			return an
+		}
+	}
+	return nil
+}
+
+func (r *request) alertNotificationID() int64 {
+	id, _ := strconv.ParseInt(r.params["id"], 10, 64)
+	return id
+}
+
+func (s *Server) getAllAlertNotifications(r *request) (int, interface{}) {
+	list := make([]sdk.AlertNotification, 0, len(r.org.alertNotifications))
+	for _, an := range r.org.alertNotifications {
+		list = append(list, *an)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return http.StatusOK, list
+}
+
+func (s *Server) getAlertNotificationByUID(r *request) (int, interface{}) {
+	return getAlertNotification(r.org.alertNotificationByUID(r.params["uid"]))
+}
+
+func (s *Server) getAlertNotificationByID(r *request) (int, interface{}) {
+	return getAlertNotification(r.org.alertNotifications[r.alertNotificationID()])
+}
+
+func getAlertNotification(an *sdk.AlertNotification) (int, interface{}) {
+	if an == nil {
+		return http.StatusNotFound, message("Alert notification not found")
+	}
+	return http.StatusOK, an
+}
+
+func (s *Server) createAlertNotification(r *request) (int, interface{}) {
+	var an sdk.AlertNotification
+	if err := r.decode(&an); err != nil {
+		return badRequest(err)
+	}
+	if r.org.alertNotificationByName(an.Name) != nil {
+		return http.StatusConflict, message("Alert notification with same name already exists")
+	}
+	if an.UID != "" && r.org.alertNotificationByUID(an.UID) != nil {
+		return http.StatusConflict, message("Alert notification with same uid already exists")
+	}
+	an.ID = int64(s.nextID("alert-notification"))
+	if an.UID == "" {
+		an.UID = fmt.Sprintf("sdktest-%d", an.ID)
+	}
+	r.org.alertNotifications[an.ID] = &an
+	return http.StatusOK, an
+}
+
+func (s *Server) updateAlertNotificationByUID(r *request) (int, interface{}) {
+	return s.updateAlertNotification(r, r.org.alertNotificationByUID(r.params["uid"]))
+}
+
+func (s *Server) updateAlertNotificationByID(r *request) (int, interface{}) {
+	return s.updateAlertNotification(r, r.org.alertNotifications[r.alertNotificationID()])
+}
+
+func (s *Server) updateAlertNotification(r *request, existing *sdk.AlertNotification) (int, interface{}) {
+	var an sdk.AlertNotification
+	if err := r.decode(&an); err != nil {
+		return badRequest(err)
+	}
+	if existing == nil {
+		return http.StatusNotFound, message("Alert notification not found")
+	}
+	if other := r.org.alertNotificationByName(an.Name); other != nil && other != existing {
+		return http.StatusConflict, message("Alert notification with same name already exists")
+	}
+	an.ID = existing.ID
+	if an.UID == "" {
+		an.UID = existing.UID
+	}
+	*existing = an
+	return http.StatusOK, existing
+}
+
+func (s *Server) deleteAlertNotificationByUID(r *request) (int, interface{}) {
+	return s.deleteAlertNotification(r, r.org.alertNotificationByUID(r.params["uid"]))
+}
+
+func (s *Server) deleteAlertNotificationByID(r *request) (int, interface{}) {
+	return s.deleteAlertNotification(r, r.org.alertNotifications[r.alertNotificationID()])
+}
+
+func (s *Server) deleteAlertNotification(r *request, an *sdk.AlertNotification) (int, interface{}) {
+	if an == nil {
+		return http.StatusNotFound, message("Alert notification not found")
+	}
+	delete(r.org.alertNotifications, an.ID)
+	return http.StatusOK, message("Notification deleted")
+}
@@ -0,0 +1,117 @@
+package sdktest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/bdunavant/sdk"
+)
+
+func (s *Server) getAnnotations(r *request) (int, interface{}) {
+	var (
+		q     = r.URL.Query()
+		limit = 100
+		list  = []sdk.AnnotationResponse{}
+	)
+	if v, err := strconv.Atoi(q.Get("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	from, _ := strconv.ParseInt(q.Get("from"), 10, 64)
+	to, _ := strconv.ParseInt(q.Get("to"), 10, 64)
+	for _, a := range r.org.annotations {
+		if t := q.Get("type"); t != "" && t != a.Type {
+			continue
+		}
+		if !matchUintParam(q.Get("dashboardId"), a.DashboardID) ||
+			!matchUintParam(q.Get("panelId"), a.PanelID) ||
+			!matchUintParam(q.Get("userId"), a.UserID) {
+			continue
+		}
+		// as Grafana the time range is applied only when both ends are set
+		if from > 0 && to > 0 && (a.Time > to || a.TimeEnd < from) {
+			continue
+		}
+		tags, _ := a.Tags.([]string)
+		if !hasTags(tags, q["tags"]) {
+			continue
+		}
+		list = append(list, *a)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Time != list[j].Time {
+			return list[i].Time > list[j].Time
+		}
+		return list[i].ID > list[j].ID
+	})
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return http.StatusOK, list
+}
+
+func matchUintParam(param string, value uint) bool {
+	if param == "" {
+		return true
+	}
+	v, err := strconv.ParseUint(param, 10, 64)
+	return err == nil && uint(v) == value
+}
+
+func (s *Server) createAnnotation(r *request) (int, interface{}) {
+	var in sdk.CreateAnnotationRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	a := &sdk.AnnotationResponse{
+		ID:          s.nextID("annotation"),
+		DashboardID: in.DashboardID,
+		PanelID:     in.PanelID,
+		UserID:      r.user.ID,
+		UserName:    r.user.Login,
+		Time:        in.Time,
+		TimeEnd:     in.TimeEnd,
+		Tags:        append([]string{}, in.Tags...),
+		Text:        in.Text,
+		Type:        "annotation",
+		Data:        map[string]interface{}{},
+	}
+	if a.TimeEnd == 0 {
+		a.TimeEnd = a.Time
+	}
+	r.org.annotations[a.ID] = a
+	return http.StatusOK, map[string]interface{}{"id": a.ID, "message": "Annotation added"}
+}
+
+func (s *Server) patchAnnotation(r *request) (int, interface{}) {
+	var in sdk.PatchAnnotationRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	a := r.org.annotations[r.uintParam("id")]
+	if a == nil {
+		return http.StatusNotFound, message("Annotation not found")
+	}
+	if in.Time != 0 {
+		a.Time = in.Time
+	}
+	if in.TimeEnd != 0 {
+		a.TimeEnd = in.TimeEnd
+	}
+	if in.Tags != nil {
+		a.Tags = append([]string{}, in.Tags...)
+	}
+	if in.Text != "" {
+		a.Text = in.Text
+	}
+	return http.StatusOK, message("Annotation patched")
+}
+
+func (s *Server) deleteAnnotation(r *request) (int, interface{}) {
+	id := r.uintParam("id")
+	if r.org.annotations[id] == nil {
+		return http.StatusNotFound, message("Annotation not found")
+	}
+	delete(r.org.annotations, id)
+	return http.StatusOK, message("Annotation deleted")
+}
@@ -0,0 +1,744 @@
+package sdktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bdunavant/sdk"
+	"github.com/gosimple/slug"
+)
+
+// dashboard keeps the model of a dashboard untouched along with its metadata.
+type dashboard struct {
+	model     map[string]interface{}
+	id        uint
+	uid       string
+	title     string
+	slug      string
+	tags      []string
+	folderID  int
+	version   int
+	created   time.Time
+	updated   time.Time
+	starredBy map[uint]bool
+	// versions keeps the history from the oldest to the newest
+	versions []*sdk.DashboardVersion
+	data     map[int]map[string]interface{}
+}
+
+func (d *dashboard) url() string {
+	return fmt.Sprintf("/d/%s/%s", d.uid, d.slug)
+}
+
+func makeSlug(title string) string {
+	return strings.ToLower(slug.Make(title))
+}
+
+func folderURL(f *sdk.Folder) string {
+	return fmt.Sprintf("/dashboards/f/%s/%s", f.UID, makeSlug(f.Title))
+}
+
+func (o *org) dashboardByUID(uid string) *dashboard {
+	for _, d := range o.dashboards {
+		if d.uid == uid {
+			return d
+		}
+	}
+	return nil
+}
+
+func (o *org) dashboardBySlug(slug string) *dashboard {
+	for _, d := range o.dashboards {
+		if d.slug == slug {
+			return d
+		}
+	}
+	return nil
+}
+
+func (o *org) dashboardByTitle(folderID int, title string) *dashboard {
+	for _, d := range o.dashboards {
+		if d.folderID == folderID && strings.EqualFold(d.title, title) {
+			return d
+		}
+	}
+	return nil
+}
+
+func (o *org) folderByUID(uid string) *sdk.Folder {
+	for _, f := range o.folders {
+		if f.UID == uid {
+			return f
+		}
+	}
+	return nil
+}
+
+func (o *org) folderByTitle(title string) *sdk.Folder {
+	for _, f := range o.folders {
+		if strings.EqualFold(f.Title, title) {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *Server) dashboardMeta(r *request, d *dashboard) map[string]interface{} {
+	meta := map[string]interface{}{
+		"type":        "db",
+		"canSave":     true,
+		"canEdit":     true,
+		"canAdmin":    true,
+		"canStar":     true,
+		"isStarred":   d.starredBy[r.user.ID],
+		"slug":        d.slug,
+		"url":         d.url(),
+		"expires":     time.Time{},
+		"created":     d.created,
+		"updated":     d.updated,
+		"createdBy":   AdminLogin,
+		"updatedBy":   AdminLogin,
+		"version":     d.version,
+		"folderId":    d.folderID,
+		"folderTitle": "General",
+		"folderUrl":   "",
+	}
+	if f := r.org.folders[uint(d.folderID)]; f != nil {
+		meta["folderUid"] = f.UID
+		meta["folderTitle"] = f.Title
+		meta["folderUrl"] = folderURL(f)
+	}
+	return meta
+}
+
+func (s *Server) getDashboardByUID(r *request) (int, interface{}) {
+	return s.getDashboard(r, r.org.dashboardByUID(r.params["uid"]))
+}
+
+func (s *Server) getDashboardBySlug(r *request) (int, interface{}) {
+	return s.getDashboard(r, r.org.dashboardBySlug(r.params["slug"]))
+}
+
+func (s *Server) getDashboard(r *request, d *dashboard) (int, interface{}) {
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	return http.StatusOK, map[string]interface{}{
+		"dashboard": d.model,
+		"meta":      s.dashboardMeta(r, d),
+	}
+}
+
+// getHomeDashboard redirects to the home dashboard of the user, team or
+// organization preferences.
+func (s *Server) getHomeDashboard(r *request) (int, interface{}) {
+	prefs := r.org.effectivePrefs(r.user.ID)
+	if uid, ok := prefs["homeDashboardUID"].(string); ok && uid != "" {
+		if d := r.org.dashboardByUID(uid); d != nil {
+			return http.StatusOK, map[string]interface{}{"redirectUri": d.url()}
+		}
+	}
+	if id, ok := toInt(prefs["homeDashboardId"]); ok && id != 0 {
+		if d := r.org.dashboards[uint(id)]; d != nil {
+			return http.StatusOK, map[string]interface{}{"redirectUri": d.url()}
+		}
+	}
+	return http.StatusOK, map[string]interface{}{
+		"dashboard": map[string]interface{}{
+			"title":         "Home",
+			"uid":           "",
+			"version":       0,
+			"schemaVersion": 27,
+			"panels":        []interface{}{},
+		},
+		"meta": map[string]interface{}{
+			"isHome":  true,
+			"canSave": false,
+			"canEdit": true,
+			"canStar": false,
+			"slug":    "",
+			"expires": time.Time{},
+			"created": time.Time{},
+			"updated": time.Time{},
+		},
+	}
+}
+
+func (s *Server) getDashboardTags(r *request) (int, interface{}) {
+	counts := make(map[string]int)
+	for _, d := range r.org.dashboards {
+		for _, tag := range d.tags {
+			counts[tag]++
+		}
+	}
+	tags := make([]sdk.DashboardTag, 0, len(counts))
+	for term, count := range counts {
+		tags = append(tags, sdk.DashboardTag{Term: term, Count: count})
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Term < tags[j].Term })
+	return http.StatusOK, tags
+}
+
+// starredDashboard returns the dashboard of the star request by uid or
+// by id.
+func (s *Server) starredDashboard(r *request) *dashboard {
+	if uid, ok := r.params["uid"]; ok {
+		return r.org.dashboardByUID(uid)
+	}
+	return r.org.dashboards[r.uintParam("dashboardId")]
+}
+
+func (s *Server) starDashboard(r *request) (int, interface{}) {
+	d := s.starredDashboard(r)
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	d.starredBy[r.user.ID] = true
+	return http.StatusOK, message("Dashboard starred!")
+}
+
+func (s *Server) unstarDashboard(r *request) (int, interface{}) {
+	d := s.starredDashboard(r)
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	delete(d.starredBy, r.user.ID)
+	return http.StatusOK, message("Dashboard unstarred")
+}
+
+// saveRequest is the body of the dashboard saving request.
+type saveRequest struct {
+	Dashboard map[string]interface{} `json:"dashboard"`
+	FolderID  int                    `json:"folderId"`
+	FolderUID string                 `json:"folderUid"`
+	Overwrite bool                   `json:"overwrite"`
+	Message   string                 `json:"message"`
+}
+
+func (s *Server) setDashboard(r *request) (int, interface{}) {
+	var in saveRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	d, code, reply := s.saveDashboard(r, in)
+	if d == nil {
+		return code, reply
+	}
+	return http.StatusOK, d.saved()
+}
+
+// saveDashboard stores the dashboard, the reply is returned if it fails.
+func (s *Server) saveDashboard(r *request, in saveRequest) (*dashboard, int, interface{}) {
+	if in.Dashboard == nil {
+		return nil, http.StatusBadRequest, message("Dashboard is required")
+	}
+	var (
+		id, _      = toInt(in.Dashboard["id"])
+		version, _ = toInt(in.Dashboard["version"])
+		uid, _     = in.Dashboard["uid"].(string)
+		title, _   = in.Dashboard["title"].(string)
+		existing   *dashboard
+	)
+	if strings.TrimSpace(title) == "" {
+		return nil, http.StatusBadRequest, message("Dashboard title cannot be empty")
+	}
+	if in.FolderUID != "" {
+		f := r.org.folderByUID(in.FolderUID)
+		if f == nil {
+			return nil, http.StatusBadRequest, message("Folder not found")
+		}
+		in.FolderID = f.ID
+	}
+	if in.FolderID != 0 && r.org.folders[uint(in.FolderID)] == nil {
+		return nil, http.StatusBadRequest, message("Folder not found")
+	}
+	if uid != "" {
+		existing = r.org.dashboardByUID(uid)
+	}
+	if existing == nil && id != 0 {
+		if existing = r.org.dashboards[uint(id)]; existing == nil {
+			return nil, http.StatusNotFound, message("Dashboard not found")
+		}
+	}
+	if existing != nil && !in.Overwrite && existing.version != version {
+		return nil, http.StatusPreconditionFailed, map[string]string{
+			"status":  "version-mismatch",
+			"message": "The dashboard has been changed by someone else",
+		}
+	}
+	if same := r.org.dashboardByTitle(in.FolderID, title); same != nil && same != existing {
+		if !in.Overwrite {
+			return nil, http.StatusPreconditionFailed, map[string]string{
+				"status":  "name-exists",
+				"message": "A dashboard with the same name in the folder already exists",
+			}
+		}
+		if existing == nil {
+			existing = same
+		} else {
+			delete(r.org.dashboards, same.id)
+		}
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	d := &dashboard{model: in.Dashboard, created: now, starredBy: make(map[uint]bool), data: make(map[int]map[string]interface{})}
+	if existing != nil {
+		d.id = existing.id
+		d.uid = existing.uid
+		d.version = existing.version + 1
+		d.created = existing.created
+		d.starredBy = existing.starredBy
+		d.versions = existing.versions
+		d.data = existing.data
+	} else {
+		d.id = s.nextID("dashboard")
+		d.uid = fmt.Sprintf("sdktest-%d", d.id)
+		d.version = 1
+	}
+	if uid != "" {
+		d.uid = uid
+	}
+	d.title = title
+	d.slug = makeSlug(title)
+	d.folderID = in.FolderID
+	d.updated = now
+	d.tags = toStrings(in.Dashboard["tags"])
+	d.model["id"] = d.id
+	d.model["uid"] = d.uid
+	d.model["version"] = d.version
+	r.org.dashboards[d.id] = d
+	d.addVersion(r.user.Login, in.Message, 0)
+	return d, 0, nil
+}
+
+func (s *Server) importDashboard(r *request) (int, interface{}) {
+	var in sdk.ImportDashboardRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	raw, err := sdk.ResolveDashboardInputs(in.Dashboard, in.Inputs)
+	if err != nil {
+		return http.StatusBadRequest, message("%s", err)
+	}
+	save := saveRequest{FolderID: in.FolderID, FolderUID: in.FolderUID, Overwrite: in.Overwrite}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err = dec.Decode(&save.Dashboard); err != nil {
+		return badRequest(err)
+	}
+	d, code, reply := s.saveDashboard(r, save)
+	if d == nil {
+		return code, reply
+	}
+	return http.StatusOK, sdk.ImportDashboardResponse{
+		UID:         d.uid,
+		Title:       d.title,
+		Imported:    true,
+		ImportedURI: "db/" + d.slug,
+		ImportedURL: d.url(),
+		Slug:        d.slug,
+		DashboardID: d.id,
+		FolderID:    d.folderID,
+		FolderUID:   in.FolderUID,
+		Revision:    1,
+	}
+}
+
+// saved returns the reply to the saving of the dashboard.
+func (d *dashboard) saved() map[string]interface{} {
+	return map[string]interface{}{
+		"id":      d.id,
+		"uid":     d.uid,
+		"url":     d.url(),
+		"slug":    d.slug,
+		"status":  "success",
+		"version": d.version,
+	}
+}
+
+// addVersion records the current model of the dashboard in the history.
+func (d *dashboard) addVersion(user, message string, restoredFrom int) {
+	v := &sdk.DashboardVersion{
+		ID:            uint(len(d.versions) + 1),
+		DashboardID:   d.id,
+		DashboardUID:  d.uid,
+		ParentVersion: d.version - 1,
+		RestoredFrom:  restoredFrom,
+		Version:       d.version,
+		Created:       d.updated,
+		CreatedBy:     user,
+		Message:       message,
+	}
+	d.versions = append(d.versions, v)
+	d.data[d.version] = copyModel(d.model)
+}
+
+// copyModel returns the deep copy of the dashboard model.
+func copyModel(model map[string]interface{}) map[string]interface{} {
+	raw, _ := json.Marshal(model)
+	var out map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	dec.Decode(&out)
+	return out
+}
+
+func (s *Server) getDashboardVersions(r *request) (int, interface{}) {
+	d := r.org.dashboardByUID(r.params["uid"])
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	var (
+		q        = r.URL.Query()
+		limit, _ = strconv.Atoi(q.Get("limit"))
+		start, _ = strconv.Atoi(q.Get("start"))
+		list     = []sdk.DashboardVersion{}
+	)
+	for i := len(d.versions) - 1; i >= 0; i-- {
+		list = append(list, *d.versions[i])
+	}
+	if start > len(list) {
+		start = len(list)
+	}
+	list = list[start:]
+	if limit > 0 && limit < len(list) {
+		list = list[:limit]
+	}
+	return http.StatusOK, list
+}
+
+func (s *Server) getDashboardVersion(r *request) (int, interface{}) {
+	d := r.org.dashboardByUID(r.params["uid"])
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	version, _ := strconv.Atoi(r.params["version"])
+	for _, v := range d.versions {
+		if v.Version == version {
+			return http.StatusOK, map[string]interface{}{
+				"id":            v.ID,
+				"dashboardId":   v.DashboardID,
+				"uid":           v.DashboardUID,
+				"parentVersion": v.ParentVersion,
+				"restoredFrom":  v.RestoredFrom,
+				"version":       v.Version,
+				"created":       v.Created,
+				"createdBy":     v.CreatedBy,
+				"message":       v.Message,
+				"data":          d.data[v.Version],
+			}
+		}
+	}
+	return http.StatusNotFound, message("Dashboard version not found")
+}
+
+func (s *Server) restoreDashboardVersion(r *request) (int, interface{}) {
+	var in struct {
+		Version int `json:"version"`
+	}
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	d := r.org.dashboardByUID(r.params["uid"])
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	data := d.data[in.Version]
+	if data == nil {
+		return http.StatusNotFound, message("Dashboard version not found")
+	}
+	d.model = copyModel(data)
+	d.version++
+	d.updated = time.Now().UTC().Truncate(time.Second)
+	d.title, _ = d.model["title"].(string)
+	d.slug = makeSlug(d.title)
+	d.tags = toStrings(d.model["tags"])
+	d.model["version"] = d.version
+	d.addVersion(r.user.Login, fmt.Sprintf("Restored from version %d", in.Version), in.Version)
+	return http.StatusOK, d.saved()
+}
+
+func (s *Server) deleteDashboardByUID(r *request) (int, interface{}) {
+	return s.deleteDashboard(r, r.org.dashboardByUID(r.params["uid"]))
+}
+
+func (s *Server) deleteDashboardBySlug(r *request) (int, interface{}) {
+	return s.deleteDashboard(r, r.org.dashboardBySlug(r.params["slug"]))
+}
+
+func (s *Server) deleteDashboard(r *request, d *dashboard) (int, interface{}) {
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	delete(r.org.dashboards, d.id)
+	return http.StatusOK, map[string]interface{}{
+		"id":      d.id,
+		"title":   d.title,
+		"message": fmt.Sprintf("Dashboard %s deleted", d.title),
+	}
+}
+
+func (s *Server) search(r *request) (int, interface{}) {
+	var (
+		q             = r.URL.Query()
+		query         = strings.ToLower(q.Get("query"))
+		tags          = q["tag"]
+		searchType    = q.Get("type")
+		starred       = q.Get("starred") == "true"
+		dashboardIDs  = make(map[int]bool)
+		dashboardUIDs = make(map[string]bool)
+		folderIDs     = make(map[int]bool)
+		limit         = 1000
+		page          = 1
+		folders       []sdk.FoundBoard
+		boards        []sdk.FoundBoard
+	)
+	for _, v := range q["dashboardIds"] {
+		id, _ := strconv.Atoi(v)
+		dashboardIDs[id] = true
+	}
+	for _, v := range q["dashboardUIDs"] {
+		dashboardUIDs[v] = true
+	}
+	for _, v := range q["folderIds"] {
+		id, _ := strconv.Atoi(v)
+		folderIDs[id] = true
+	}
+	if v, err := strconv.Atoi(q.Get("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	if v, err := strconv.Atoi(q.Get("page")); err == nil && v > 0 {
+		page = v
+	}
+	if searchType != "dash-db" && len(tags) == 0 && len(dashboardIDs) == 0 && len(dashboardUIDs) == 0 && len(folderIDs) == 0 && !starred {
+		for _, f := range r.org.folders {
+			if query != "" && !strings.Contains(strings.ToLower(f.Title), query) {
+				continue
+			}
+			folders = append(folders, sdk.FoundBoard{
+				ID:    uint(f.ID),
+				UID:   f.UID,
+				Title: f.Title,
+				URI:   "db/" + makeSlug(f.Title),
+				URL:   folderURL(f),
+				Type:  "dash-folder",
+				Tags:  []string{},
+			})
+		}
+	}
+	if searchType != "dash-folder" {
+		for _, d := range r.org.dashboards {
+			if query != "" && !strings.Contains(strings.ToLower(d.title), query) {
+				continue
+			}
+			if !hasTags(d.tags, tags) ||
+				(len(dashboardIDs) > 0 && !dashboardIDs[int(d.id)]) ||
+				(len(dashboardUIDs) > 0 && !dashboardUIDs[d.uid]) ||
+				(len(folderIDs) > 0 && !folderIDs[d.folderID]) ||
+				(starred && !d.starredBy[r.user.ID]) {
+				continue
+			}
+			found := sdk.FoundBoard{
+				ID:        d.id,
+				UID:       d.uid,
+				Title:     d.title,
+				URI:       "db/" + d.slug,
+				URL:       d.url(),
+				Type:      "dash-db",
+				Tags:      d.tags,
+				IsStarred: d.starredBy[r.user.ID],
+				FolderID:  d.folderID,
+			}
+			if f := r.org.folders[uint(d.folderID)]; f != nil {
+				found.FolderUID = f.UID
+				found.FolderTitle = f.Title
+				found.FolderURL = folderURL(f)
+			}
+			boards = append(boards, found)
+		}
+	}
+	sortFound(folders)
+	sortFound(boards)
+	result := append(folders, boards...)
+	start := (page - 1) * limit
+	if start >= len(result) {
+		return http.StatusOK, []sdk.FoundBoard{}
+	}
+	end := start + limit
+	if end > len(result) {
+		end = len(result)
+	}
+	return http.StatusOK, result[start:end]
+}
+
+func sortFound(found []sdk.FoundBoard) {
+	sort.Slice(found, func(i, j int) bool {
+		if a, b := strings.ToLower(found[i].Title), strings.ToLower(found[j].Title); a != b {
+			return a < b
+		}
+		return found[i].ID < found[j].ID
+	})
+}
+
+// hasTags checks that all wanted tags are present as Grafana does.
+func hasTags(tags, wanted []string) bool {
+	for _, w := range wanted {
+		found := false
+		for _, t := range tags {
+			if t == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) getAllFolders(r *request) (int, interface{}) {
+	folders := make([]sdk.Folder, 0, len(r.org.folders))
+	for _, f := range r.org.folders {
+		folders = append(folders, *f)
+	}
+	sort.Slice(folders, func(i, j int) bool { return folders[i].Title < folders[j].Title })
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit > 0 && limit < len(folders) {
+		folders = folders[:limit]
+	}
+	return http.StatusOK, folders
+}
+
+func (s *Server) getFolderByUID(r *request) (int, interface{}) {
+	f := r.org.folderByUID(r.params["uid"])
+	if f == nil {
+		return http.StatusNotFound, message("Folder not found")
+	}
+	return http.StatusOK, f
+}
+
+func (s *Server) getFolderByID(r *request) (int, interface{}) {
+	f := r.org.folders[r.uintParam("id")]
+	if f == nil {
+		return http.StatusNotFound, message("Folder not found")
+	}
+	return http.StatusOK, f
+}
+
+func (s *Server) createFolder(r *request) (int, interface{}) {
+	var in sdk.Folder
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if strings.TrimSpace(in.Title) == "" {
+		return http.StatusBadRequest, message("Folder title cannot be empty")
+	}
+	if in.UID != "" && r.org.folderByUID(in.UID) != nil {
+		return http.StatusConflict, message("A folder with the same uid already exists")
+	}
+	if r.org.folderByTitle(in.Title) != nil {
+		return http.StatusConflict, message("A folder or dashboard in the general folder with the same name already exists")
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	f := &sdk.Folder{
+		ID:        int(s.nextID("dashboard")),
+		UID:       in.UID,
+		Title:     in.Title,
+		CanSave:   true,
+		CanEdit:   true,
+		CanAdmin:  true,
+		CreatedBy: r.user.Login,
+		Created:   now,
+		UpdatedBy: r.user.Login,
+		Updated:   now,
+		Version:   1,
+	}
+	if f.UID == "" {
+		f.UID = fmt.Sprintf("sdktest-%d", f.ID)
+	}
+	f.URL = folderURL(f)
+	r.org.folders[uint(f.ID)] = f
+	return http.StatusOK, f
+}
+
+func (s *Server) updateFolder(r *request) (int, interface{}) {
+	var in sdk.Folder
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	f := r.org.folderByUID(r.params["uid"])
+	if f == nil {
+		return http.StatusNotFound, message("Folder not found")
+	}
+	if !in.Overwrite && in.Version != f.Version {
+		return http.StatusPreconditionFailed, map[string]string{
+			"status":  "version-mismatch",
+			"message": "The folder has been changed by someone else",
+		}
+	}
+	if other := r.org.folderByTitle(in.Title); other != nil && other != f {
+		return http.StatusConflict, message("A folder or dashboard in the general folder with the same name already exists")
+	}
+	if in.Title != "" {
+		f.Title = in.Title
+	}
+	if in.UID != "" {
+		f.UID = in.UID
+	}
+	f.Version++
+	f.UpdatedBy = r.user.Login
+	f.Updated = time.Now().UTC().Format(time.RFC3339)
+	f.URL = folderURL(f)
+	return http.StatusOK, f
+}
+
+func (s *Server) deleteFolder(r *request) (int, interface{}) {
+	f := r.org.folderByUID(r.params["uid"])
+	if f == nil {
+		return http.StatusNotFound, message("Folder not found")
+	}
+	for id, d := range r.org.dashboards {
+		if d.folderID == f.ID {
+			delete(r.org.dashboards, id)
+		}
+	}
+	delete(r.org.folders, uint(f.ID))
+	return http.StatusOK, map[string]interface{}{
+		"id":      f.ID,
+		"title":   f.Title,
+		"message": fmt.Sprintf("Folder %s deleted", f.Title),
+	}
+}
+
+// toInt converts JSON number decoded with UseNumber to int.
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		i, err := n.Int64()
+		return int(i), err == nil
+	case float64:
+		return int(n), true
+	case int:
+		return n, true
+	case uint:
+		return int(n), true
+	}
+	return 0, false
+}
+
+func toStrings(v interface{}) []string {
+	out := []string{}
+	if list, ok := v.([]interface{}); ok {
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,203 @@
+package sdktest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/bdunavant/sdk"
+)
+
+// public returns the datasource as Grafana shows it, secure data is
+// never returned back.
+func publicDatasource(ds *sdk.Datasource) sdk.Datasource {
+	out := *ds
+	out.SecureJSONData = nil
+	return out
+}
+
+func (o *org) datasourceByName(name string) *sdk.Datasource {
+	for _, ds := range o.datasources {
+		if ds.Name == name {
+			return ds
+		}
+	}
+	return nil
+}
+
+func (s *Server) getAllDatasources(r *request) (int, interface{}) {
+	list := make([]sdk.Datasource, 0, len(r.org.datasources))
+	for _, ds := range r.org.datasources {
+		list = append(list, publicDatasource(ds))
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return http.StatusOK, list
+}
+
+func (s *Server) getDatasource(r *request) (int, interface{}) {
+	ds := r.org.datasources[r.uintParam("id")]
+	if ds == nil {
+		return http.StatusNotFound, message("Data source not found")
+	}
+	return http.StatusOK, publicDatasource(ds)
+}
+
+func (s *Server) getDatasourceByName(r *request) (int, interface{}) {
+	ds := r.org.datasourceByName(r.params["name"])
+	if ds == nil {
+		return http.StatusNotFound, message("Data source not found")
+	}
+	return http.StatusOK, publicDatasource(ds)
+}
+
+func (s *Server) createDatasource(r *request) (int, interface{}) {
+	var ds sdk.Datasource
+	if err := r.decode(&ds); err != nil {
+		return badRequest(err)
+	}
+	if ds.Name == "" {
+		return http.StatusBadRequest, message("Data source name is required")
+	}
+	if r.org.datasourceByName(ds.Name) != nil {
+		return http.StatusConflict, message("Data source with the same name already exists")
+	}
+	ds.ID = s.nextID("datasource")
+	ds.OrgID = r.org.ID
+	if ds.UID == "" {
+		ds.UID = fmt.Sprintf("sdktest-datasource-%d", ds.ID)
+	}
+	r.org.datasources[ds.ID] = &ds
+	return http.StatusOK, map[string]interface{}{
+		"id":         ds.ID,
+		"name":       ds.Name,
+		"message":    "Datasource added",
+		"datasource": publicDatasource(&ds),
+	}
+}
+
+func (s *Server) updateDatasource(r *request) (int, interface{}) {
+	var ds sdk.Datasource
+	if err := r.decode(&ds); err != nil {
+		return badRequest(err)
+	}
+	id := r.uintParam("id")
+	if r.org.datasources[id] == nil {
+		return http.StatusNotFound, message("Data source not found")
+	}
+	if other := r.org.datasourceByName(ds.Name); other != nil && other.ID != id {
+		return http.StatusConflict, message("Data source with the same name already exists")
+	}
+	ds.ID = id
+	ds.OrgID = r.org.ID
+	if ds.UID == "" {
+		ds.UID = r.org.datasources[id].UID
+	}
+	r.org.datasources[id] = &ds
+	return http.StatusOK, map[string]interface{}{
+		"id":         id,
+		"name":       ds.Name,
+		"message":    "Datasource updated",
+		"datasource": publicDatasource(&ds),
+	}
+}
+
+func (s *Server) deleteDatasource(r *request) (int, interface{}) {
+	id := r.uintParam("id")
+	if r.org.datasources[id] == nil {
+		return http.StatusNotFound, message("Data source not found")
+	}
+	delete(r.org.datasources, id)
+	return http.StatusOK, message("Data source deleted")
+}
+
+func (s *Server) deleteDatasourceByName(r *request) (int, interface{}) {
+	ds := r.org.datasourceByName(r.params["name"])
+	if ds == nil {
+		return http.StatusNotFound, message("Data source not found")
+	}
+	delete(r.org.datasources, ds.ID)
+	return http.StatusOK, map[string]interface{}{"id": ds.ID, "message": "Data source deleted"}
+}
+
+func (s *Server) getDatasourceTypes(r *request) (int, interface{}) {
+	types := make(map[string]sdk.DatasourceType)
+	for _, name := range []string{"prometheus", "graphite", "elasticsearch", "influxdb", "postgres"} {
+		types[name] = sdk.DatasourceType{
+			Metrics:    true,
+			Module:     "app/plugins/datasource/" + name + "/module",
+			Name:       name,
+			PluginType: "datasource",
+			Type:       name,
+		}
+	}
+	return http.StatusOK, types
+}
+
+// queryDatasources answers every query with the frame of two points at
+// the bounds of the time range, the values are the number of the query.
+func (s *Server) queryDatasources(r *request) (int, interface{}) {
+	var in struct {
+		From    string                   `json:"from"`
+		To      string                   `json:"to"`
+		Queries []map[string]interface{} `json:"queries"`
+	}
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if len(in.Queries) == 0 {
+		return http.StatusBadRequest, message("No queries found in query")
+	}
+	var (
+		code    = http.StatusOK
+		from, _ = strconv.ParseInt(in.From, 10, 64)
+		to, _   = strconv.ParseInt(in.To, 10, 64)
+		results = make(map[string]interface{}, len(in.Queries))
+	)
+	for i, q := range in.Queries {
+		refID, _ := q["refId"].(string)
+		var ds *sdk.Datasource
+		if ref, ok := q["datasource"].(map[string]interface{}); ok {
+			uid, _ := ref["uid"].(string)
+			ds = r.org.datasourceByUID(uid)
+		}
+		if id, ok := toInt(q["datasourceId"]); ds == nil && ok {
+			ds = r.org.datasources[uint(id)]
+		}
+		if ds == nil {
+			code = http.StatusBadRequest
+			results[refID] = map[string]interface{}{"error": "data source not found", "status": http.StatusBadRequest}
+			continue
+		}
+		name, _ := q["expr"].(string)
+		if name == "" {
+			name = refID
+		}
+		results[refID] = map[string]interface{}{
+			"status": http.StatusOK,
+			"frames": []interface{}{map[string]interface{}{
+				"schema": map[string]interface{}{
+					"name":  name,
+					"refId": refID,
+					"fields": []map[string]interface{}{
+						{"name": "Time", "type": "time"},
+						{"name": "Value", "type": "number", "labels": map[string]string{"datasource": ds.Name}},
+					},
+				},
+				"data": map[string]interface{}{
+					"values": [][]int64{{from, to}, {int64(i + 1), int64(i + 1)}},
+				},
+			}},
+		}
+	}
+	return code, map[string]interface{}{"results": results}
+}
+
+func (o *org) datasourceByUID(uid string) *sdk.Datasource {
+	for _, ds := range o.datasources {
+		if uid != "" && ds.UID == uid {
+			return ds
+		}
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package sdktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bdunavant/sdk"
+)
+
+// libraryPanelUIDs returns uids of library panels referenced by panels
+// of the dashboard model including panels of collapsed rows.
+func libraryPanelUIDs(model map[string]interface{}) map[string]bool {
+	uids := make(map[string]bool)
+	var walk func(panels interface{})
+	walk = func(panels interface{}) {
+		list, _ := panels.([]interface{})
+		for _, item := range list {
+			panel, _ := item.(map[string]interface{})
+			if ref, ok := panel["libraryPanel"].(map[string]interface{}); ok {
+				if uid, _ := ref["uid"].(string); uid != "" {
+					uids[uid] = true
+				}
+			}
+			walk(panel["panels"])
+		}
+	}
+	walk(model["panels"])
+	return uids
+}
+
+func (o *org) libraryElementConnections(el *sdk.LibraryElement) []sdk.LibraryElementConnection {
+	conns := []sdk.LibraryElementConnection{}
+	for _, d := range o.dashboards {
+		if libraryPanelUIDs(d.model)[el.UID] {
+			conns = append(conns, sdk.LibraryElementConnection{
+				ID:            uint(len(conns) + 1),
+				Kind:          el.Kind,
+				ElementID:     el.ID,
+				ConnectionID:  d.id,
+				ConnectionUID: d.uid,
+				Created:       d.updated,
+			})
+		}
+	}
+	sort.Slice(conns, func(i, j int) bool { return conns[i].ConnectionID < conns[j].ConnectionID })
+	return conns
+}
+
+// libraryElementReply returns the element with meta filled as Grafana
+// does in the replies.
+func (s *Server) libraryElementReply(r *request, el *sdk.LibraryElement) sdk.LibraryElement {
+	out := *el
+	out.Meta.FolderName = "General"
+	if f := r.org.folders[uint(el.FolderID)]; f != nil {
+		out.Meta.FolderName = f.Title
+		out.Meta.FolderUID = f.UID
+		out.FolderUID = f.UID
+	}
+	out.Meta.ConnectedDashboards = len(r.org.libraryElementConnections(el))
+	return out
+}
+
+// setLibraryElementModel validates the model and sets the fields taken
+// from it.
+func setLibraryElementModel(el *sdk.LibraryElement, raw json.RawMessage) (int, interface{}) {
+	var model map[string]interface{}
+	if err := json.Unmarshal(raw, &model); err != nil || model == nil {
+		return http.StatusBadRequest, message("Invalid library element model")
+	}
+	el.Model = raw
+	el.Type, _ = model["type"].(string)
+	el.Description, _ = model["description"].(string)
+	return 0, nil
+}
+
+func (s *Server) libraryElementFolder(r *request, folderID int, folderUID string) (int, bool) {
+	if folderUID != "" {
+		f := r.org.folderByUID(folderUID)
+		if f == nil {
+			return 0, false
+		}
+		return f.ID, true
+	}
+	return folderID, folderID == 0 || r.org.folders[uint(folderID)] != nil
+}
+
+func (s *Server) searchLibraryElements(r *request) (int, interface{}) {
+	var (
+		q       = r.URL.Query()
+		query   = strings.ToLower(q.Get("searchString"))
+		kind, _ = strconv.Atoi(q.Get("kind"))
+		folders map[int]bool
+		perPage = 100
+		page    = 1
+		found   = []sdk.LibraryElement{}
+	)
+	if v, err := strconv.Atoi(q.Get("perPage")); err == nil && v > 0 {
+		perPage = v
+	}
+	if v, err := strconv.Atoi(q.Get("page")); err == nil && v > 0 {
+		page = v
+	}
+	if filter := q.Get("folderFilter"); filter != "" {
+		folders = make(map[int]bool)
+		for _, id := range strings.Split(filter, ",") {
+			v, _ := strconv.Atoi(id)
+			folders[v] = true
+		}
+	}
+	for _, el := range r.org.libraryElements {
+		if (kind != 0 && el.Kind != kind) ||
+			(folders != nil && !folders[el.FolderID]) ||
+			(query != "" && !strings.Contains(strings.ToLower(el.Name), query) &&
+				!strings.Contains(strings.ToLower(el.Description), query)) {
+			continue
+		}
+		found = append(found, s.libraryElementReply(r, el))
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Name < found[j].Name })
+	result := sdk.PageLibraryElements{TotalCount: len(found), Page: page, PerPage: perPage, Elements: []sdk.LibraryElement{}}
+	if start := (page - 1) * perPage; start < len(found) {
+		end := start + perPage
+		if end > len(found) {
+			end = len(found)
+		}
+		result.Elements = found[start:end]
+	}
+	return http.StatusOK, map[string]interface{}{"result": result}
+}
+
+func (s *Server) getLibraryElement(r *request) (int, interface{}) {
+	el := r.org.libraryElements[r.params["uid"]]
+	if el == nil {
+		return http.StatusNotFound, message("library element could not be found")
+	}
+	return http.StatusOK, map[string]interface{}{"result": s.libraryElementReply(r, el)}
+}
+
+func (s *Server) createLibraryElement(r *request) (int, interface{}) {
+	var in sdk.CreateLibraryElementRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if strings.TrimSpace(in.Name) == "" {
+		return http.StatusBadRequest, message("library element name cannot be empty")
+	}
+	if in.Kind != sdk.LibraryElementPanel && in.Kind != sdk.LibraryElementVariable {
+		return http.StatusBadRequest, message("invalid library element kind")
+	}
+	folderID, ok := s.libraryElementFolder(r, in.FolderID, in.FolderUID)
+	if !ok {
+		return http.StatusBadRequest, message("folder not found")
+	}
+	for _, el := range r.org.libraryElements {
+		if el.Name == in.Name && el.FolderID == folderID {
+			return http.StatusBadRequest, message("library element with that name already exists")
+		}
+	}
+	if in.UID != "" && r.org.libraryElements[in.UID] != nil {
+		return http.StatusBadRequest, message("library element with that uid already exists")
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	el := &sdk.LibraryElement{
+		ID:       s.nextID("libraryElement"),
+		OrgID:    r.org.ID,
+		FolderID: folderID,
+		UID:      in.UID,
+		Name:     in.Name,
+		Kind:     in.Kind,
+		Version:  1,
+	}
+	if el.UID == "" {
+		el.UID = fmt.Sprintf("sdktest-library-%d", el.ID)
+	}
+	if code, reply := setLibraryElementModel(el, in.Model); reply != nil {
+		return code, reply
+	}
+	user := sdk.LibraryElementUser{ID: int(r.user.ID), Name: r.user.Login}
+	el.Meta = sdk.LibraryElementMeta{Created: now, Updated: now, CreatedBy: user, UpdatedBy: user}
+	r.org.libraryElements[el.UID] = el
+	return http.StatusOK, map[string]interface{}{"result": s.libraryElementReply(r, el)}
+}
+
+func (s *Server) updateLibraryElement(r *request) (int, interface{}) {
+	var in sdk.UpdateLibraryElementRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	el := r.org.libraryElements[r.params["uid"]]
+	if el == nil {
+		return http.StatusNotFound, message("library element could not be found")
+	}
+	if in.Version != el.Version {
+		return http.StatusPreconditionFailed, message("the library element has been changed by someone else")
+	}
+	folderID, ok := s.libraryElementFolder(r, in.FolderID, in.FolderUID)
+	if !ok {
+		return http.StatusBadRequest, message("folder not found")
+	}
+	updated := *el
+	updated.FolderID = folderID
+	if in.Name != "" {
+		updated.Name = in.Name
+	}
+	if in.Model != nil {
+		if code, reply := setLibraryElementModel(&updated, in.Model); reply != nil {
+			return code, reply
+		}
+	}
+	if in.UID != "" && in.UID != el.UID {
+		if r.org.libraryElements[in.UID] != nil {
+			return http.StatusBadRequest, message("library element with that uid already exists")
+		}
+		delete(r.org.libraryElements, el.UID)
+		updated.UID = in.UID
+	}
+	updated.Version++
+	updated.Meta.Updated = time.Now().UTC().Truncate(time.Second)
+	updated.Meta.UpdatedBy = sdk.LibraryElementUser{ID: int(r.user.ID), Name: r.user.Login}
+	*el = updated
+	r.org.libraryElements[el.UID] = el
+	return http.StatusOK, map[string]interface{}{"result": s.libraryElementReply(r, el)}
+}
+
+func (s *Server) deleteLibraryElement(r *request) (int, interface{}) {
+	el := r.org.libraryElements[r.params["uid"]]
+	if el == nil {
+		return http.StatusNotFound, message("library element could not be found")
+	}
+	if len(r.org.libraryElementConnections(el)) > 0 {
+		return http.StatusForbidden, message("the library element has connections")
+	}
+	delete(r.org.libraryElements, el.UID)
+	return http.StatusOK, map[string]interface{}{"id": el.ID, "message": "Library element deleted"}
+}
+
+func (s *Server) getLibraryElementConnections(r *request) (int, interface{}) {
+	el := r.org.libraryElements[r.params["uid"]]
+	if el == nil {
+		return http.StatusNotFound, message("library element could not be found")
+	}
+	return http.StatusOK, map[string]interface{}{"result": r.org.libraryElementConnections(el)}
+}
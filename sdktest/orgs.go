@@ -0,0 +1,269 @@
+package sdktest
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/bdunavant/sdk"
+)
+
+// org keeps the state of an organization. Dashboards, folders and other
+// objects are isolated per organization as in Grafana.
+type org struct {
+	sdk.Org
+	// users maps identifiers of members to their roles
+	users map[uint]string
+	prefs map[string]interface{}
+	// userPrefs maps identifiers of members to their preferences
+	userPrefs          map[uint]map[string]interface{}
+	dashboards         map[uint]*dashboard
+	folders            map[uint]*sdk.Folder
+	datasources        map[uint]*sdk.Datasource
+	annotations        map[uint]*sdk.AnnotationResponse
+	alertNotifications map[int64]*sdk.AlertNotification
+	snapshots          map[string]*snapshot
+	serviceAccounts    map[uint]*serviceAccount
+	teams              map[uint]*team
+	// libraryElements maps uids of library elements to them
+	libraryElements map[string]*sdk.LibraryElement
+	playlists       map[string]*sdk.Playlist
+	// publicDashboards maps uids of dashboards to their public configs
+	publicDashboards map[string]*sdk.PublicDashboard
+	// permissions maps "dashboards/uid" and "folders/uid" to ACLs set
+	// by the clients, objects without ACL have the default one
+	permissions map[string][]sdk.PermissionItem
+}
+
+func (s *Server) newOrg(name string) *org {
+	o := &org{
+		Org:                sdk.Org{ID: s.nextID("org"), Name: name},
+		users:              make(map[uint]string),
+		prefs:              make(map[string]interface{}),
+		userPrefs:          make(map[uint]map[string]interface{}),
+		dashboards:         make(map[uint]*dashboard),
+		folders:            make(map[uint]*sdk.Folder),
+		datasources:        make(map[uint]*sdk.Datasource),
+		annotations:        make(map[uint]*sdk.AnnotationResponse),
+		alertNotifications: make(map[int64]*sdk.AlertNotification),
+		snapshots:          make(map[string]*snapshot),
+		serviceAccounts:    make(map[uint]*serviceAccount),
+		teams:              make(map[uint]*team),
+		publicDashboards:   make(map[string]*sdk.PublicDashboard),
+		libraryElements:    make(map[string]*sdk.LibraryElement),
+		playlists:          make(map[string]*sdk.Playlist),
+		permissions:        make(map[string][]sdk.PermissionItem),
+	}
+	s.orgs[o.ID] = o
+	return o
+}
+
+func (s *Server) orgByName(name string) *org {
+	for _, o := range s.orgs {
+		if o.Name == name {
+			return o
+		}
+	}
+	return nil
+}
+
+// orgParam finds the organization specified by orgId path parameter.
+func (s *Server) orgParam(r *request) (*org, int, interface{}) {
+	o := s.orgs[r.uintParam("orgId")]
+	if o == nil {
+		return nil, http.StatusNotFound, message("Organization not found")
+	}
+	return o, 0, nil
+}
+
+func (s *Server) createOrg(r *request) (int, interface{}) {
+	var in sdk.Org
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if s.orgByName(in.Name) != nil {
+		return http.StatusConflict, message("Organization name taken")
+	}
+	o := s.newOrg(in.Name)
+	o.Address = in.Address
+	o.users[r.user.ID] = "Admin"
+	return http.StatusOK, map[string]interface{}{"orgId": o.ID, "message": "Organization created"}
+}
+
+func (s *Server) getAllOrgs(r *request) (int, interface{}) {
+	orgs := make([]sdk.Org, 0, len(s.orgs))
+	for _, o := range s.orgs {
+		orgs = append(orgs, o.Org)
+	}
+	sort.Slice(orgs, func(i, j int) bool { return orgs[i].ID < orgs[j].ID })
+	return http.StatusOK, orgs
+}
+
+func (s *Server) getActualOrg(r *request) (int, interface{}) {
+	return http.StatusOK, r.org.Org
+}
+
+func (s *Server) getOrg(r *request) (int, interface{}) {
+	o, code, reply := s.orgParam(r)
+	if o == nil {
+		return code, reply
+	}
+	return http.StatusOK, o.Org
+}
+
+func (s *Server) getOrgByName(r *request) (int, interface{}) {
+	o := s.orgByName(r.params["name"])
+	if o == nil {
+		return http.StatusNotFound, message("Organization not found")
+	}
+	return http.StatusOK, o.Org
+}
+
+func (s *Server) updateActualOrg(r *request) (int, interface{}) {
+	return s.renameOrg(r, r.org)
+}
+
+func (s *Server) updateOrg(r *request) (int, interface{}) {
+	o, code, reply := s.orgParam(r)
+	if o == nil {
+		return code, reply
+	}
+	return s.renameOrg(r, o)
+}
+
+func (s *Server) renameOrg(r *request, o *org) (int, interface{}) {
+	var in sdk.Org
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if other := s.orgByName(in.Name); other != nil && other != o {
+		return http.StatusConflict, message("Organization name taken")
+	}
+	o.Name = in.Name
+	return http.StatusOK, message("Organization updated")
+}
+
+func (s *Server) deleteOrg(r *request) (int, interface{}) {
+	o, code, reply := s.orgParam(r)
+	if o == nil {
+		return code, reply
+	}
+	delete(s.orgs, o.ID)
+	return http.StatusOK, message("Organization deleted")
+}
+
+func (s *Server) updateActualOrgAddress(r *request) (int, interface{}) {
+	return s.updateAddress(r, r.org)
+}
+
+func (s *Server) updateOrgAddress(r *request) (int, interface{}) {
+	o, code, reply := s.orgParam(r)
+	if o == nil {
+		return code, reply
+	}
+	return s.updateAddress(r, o)
+}
+
+func (s *Server) updateAddress(r *request, o *org) (int, interface{}) {
+	var in sdk.Address
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	o.Address = in
+	return http.StatusOK, message("Address updated")
+}
+
+func (s *Server) getActualOrgUsers(r *request) (int, interface{}) {
+	return http.StatusOK, s.orgUsers(r.org)
+}
+
+func (s *Server) getOrgUsers(r *request) (int, interface{}) {
+	o, code, reply := s.orgParam(r)
+	if o == nil {
+		return code, reply
+	}
+	return http.StatusOK, s.orgUsers(o)
+}
+
+func (s *Server) orgUsers(o *org) []sdk.OrgUser {
+	users := make([]sdk.OrgUser, 0, len(o.users))
+	for id, role := range o.users {
+		u := s.users[id]
+		users = append(users, sdk.OrgUser{ID: id, OrgId: o.ID, Email: u.Email, Login: u.Login, Role: role})
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+func (s *Server) addActualOrgUser(r *request) (int, interface{}) {
+	return s.addUserToOrg(r, r.org)
+}
+
+func (s *Server) addOrgUser(r *request) (int, interface{}) {
+	o, code, reply := s.orgParam(r)
+	if o == nil {
+		return code, reply
+	}
+	return s.addUserToOrg(r, o)
+}
+
+func (s *Server) addUserToOrg(r *request, o *org) (int, interface{}) {
+	var in sdk.UserRole
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	u := s.userByLoginOrEmail(in.LoginOrEmail)
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	if _, ok := o.users[u.ID]; ok {
+		return http.StatusConflict, message("User is already member of this organization")
+	}
+	o.users[u.ID] = in.Role
+	return http.StatusOK, map[string]interface{}{"message": "User added to organization", "userId": u.ID}
+}
+
+func (s *Server) updateActualOrgUser(r *request) (int, interface{}) {
+	return s.updateUserRole(r, r.org)
+}
+
+func (s *Server) updateOrgUser(r *request) (int, interface{}) {
+	o, code, reply := s.orgParam(r)
+	if o == nil {
+		return code, reply
+	}
+	return s.updateUserRole(r, o)
+}
+
+func (s *Server) updateUserRole(r *request, o *org) (int, interface{}) {
+	var in sdk.UserRole
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	id := r.uintParam("userId")
+	if _, ok := o.users[id]; !ok {
+		return http.StatusNotFound, message("User not found")
+	}
+	o.users[id] = in.Role
+	return http.StatusOK, message("Organization user updated")
+}
+
+func (s *Server) deleteActualOrgUser(r *request) (int, interface{}) {
+	return s.removeUserFromOrg(r, r.org)
+}
+
+func (s *Server) deleteOrgUser(r *request) (int, interface{}) {
+	o, code, reply := s.orgParam(r)
+	if o == nil {
+		return code, reply
+	}
+	return s.removeUserFromOrg(r, o)
+}
+
+func (s *Server) removeUserFromOrg(r *request, o *org) (int, interface{}) {
+	id := r.uintParam("userId")
+	if _, ok := o.users[id]; !ok {
+		return http.StatusNotFound, message("User not found")
+	}
+	delete(o.users, id)
+	return http.StatusOK, message("User removed from organization")
+}
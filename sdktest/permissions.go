@@ -0,0 +1,132 @@
+package sdktest
+
+import (
+	"net/http"
+
+	"github.com/bdunavant/sdk"
+)
+
+// defaultPermissions is the ACL Grafana gives to new folders and
+// dashboards of the General folder.
+func defaultPermissions() []sdk.PermissionItem {
+	return []sdk.PermissionItem{
+		{Role: "Viewer", Permission: sdk.PermissionView},
+		{Role: "Editor", Permission: sdk.PermissionEdit},
+	}
+}
+
+// acl returns the ACL of the object with the details filled as Grafana
+// does in the replies.
+func (s *Server) acl(o *org, key string, defaults bool) []sdk.PermissionItem {
+	items, ok := o.permissions[key]
+	if !ok && defaults {
+		items = defaultPermissions()
+	}
+	out := make([]sdk.PermissionItem, 0, len(items))
+	for _, item := range items {
+		item.PermissionName = item.Permission.String()
+		if u := s.users[item.UserID]; u != nil {
+			item.UserLogin = u.Login
+			item.UserEmail = u.Email
+		}
+		if t := o.teams[item.TeamID]; t != nil {
+			item.Team = t.Name
+			item.TeamEmail = t.Email
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+func (s *Server) getDashboardPermissions(r *request) (int, interface{}) {
+	d := r.org.dashboardByUID(r.params["uid"])
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	f := r.org.folders[uint(d.folderID)]
+	items := s.acl(r.org, "dashboards/"+d.uid, f == nil)
+	for i := range items {
+		items[i].DashboardID = int(d.id)
+		items[i].UID = d.uid
+		items[i].Title = d.title
+	}
+	if f != nil {
+		for _, item := range s.acl(r.org, "folders/"+f.UID, true) {
+			item.Inherited = true
+			item.DashboardID = f.ID
+			item.UID = f.UID
+			item.Title = f.Title
+			item.IsFolder = true
+			items = append(items, item)
+		}
+	}
+	return http.StatusOK, items
+}
+
+func (s *Server) updateDashboardPermissions(r *request) (int, interface{}) {
+	d := r.org.dashboardByUID(r.params["uid"])
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	return s.updatePermissions(r, "dashboards/"+d.uid, "Dashboard permissions updated")
+}
+
+func (s *Server) getFolderPermissions(r *request) (int, interface{}) {
+	f := r.org.folderByUID(r.params["uid"])
+	if f == nil {
+		return http.StatusNotFound, message("Folder not found")
+	}
+	items := s.acl(r.org, "folders/"+f.UID, true)
+	for i := range items {
+		items[i].FolderID = f.ID
+		items[i].UID = f.UID
+		items[i].Title = f.Title
+		items[i].IsFolder = true
+	}
+	return http.StatusOK, items
+}
+
+func (s *Server) updateFolderPermissions(r *request) (int, interface{}) {
+	f := r.org.folderByUID(r.params["uid"])
+	if f == nil {
+		return http.StatusNotFound, message("Folder not found")
+	}
+	code, reply := s.updatePermissions(r, "folders/"+f.UID, "Folder permissions updated")
+	if code == http.StatusOK {
+		f.HasAcl = true
+	}
+	return code, reply
+}
+
+func (s *Server) updatePermissions(r *request, key, done string) (int, interface{}) {
+	var in struct {
+		Items []sdk.PermissionItem `json:"items"`
+	}
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	seen := make(map[sdk.PermissionItem]bool)
+	items := make([]sdk.PermissionItem, 0, len(in.Items))
+	for _, item := range in.Items {
+		principal := sdk.PermissionItem{UserID: item.UserID, TeamID: item.TeamID, Role: item.Role}
+		switch {
+		case item.Permission != sdk.PermissionView && item.Permission != sdk.PermissionEdit && item.Permission != sdk.PermissionAdmin:
+			return http.StatusBadRequest, message("Invalid permission")
+		case item.UserID != 0 && s.users[item.UserID] == nil:
+			return http.StatusBadRequest, message("User not found")
+		case item.TeamID != 0 && r.org.teams[item.TeamID] == nil:
+			return http.StatusBadRequest, message("Team not found")
+		case seen[principal]:
+			return http.StatusBadRequest, message("Permission for the same user, team or role is set twice")
+		}
+		seen[principal] = true
+		items = append(items, sdk.PermissionItem{
+			UserID:     item.UserID,
+			TeamID:     item.TeamID,
+			Role:       item.Role,
+			Permission: item.Permission,
+		})
+	}
+	r.org.permissions[key] = items
+	return http.StatusOK, message(done)
+}
@@ -0,0 +1,111 @@
+package sdktest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bdunavant/sdk"
+)
+
+// setPlaylistItems validates the items and orders them as Grafana does.
+func setPlaylistItems(p *sdk.Playlist, items []sdk.PlaylistItem) (int, interface{}) {
+	p.Items = make([]sdk.PlaylistItem, 0, len(items))
+	for i, item := range items {
+		switch item.Type {
+		case sdk.PlaylistItemByUID, sdk.PlaylistItemByTag, sdk.PlaylistItemByID:
+		default:
+			return http.StatusBadRequest, message("Invalid playlist item type %q", item.Type)
+		}
+		p.Items = append(p.Items, sdk.PlaylistItem{Type: item.Type, Value: item.Value, Order: i + 1})
+	}
+	return 0, nil
+}
+
+func (s *Server) getPlaylists(r *request) (int, interface{}) {
+	var (
+		q        = r.URL.Query()
+		query    = strings.ToLower(q.Get("query"))
+		limit, _ = strconv.Atoi(q.Get("limit"))
+		list     = []sdk.Playlist{}
+	)
+	for _, p := range r.org.playlists {
+		if query != "" && !strings.Contains(strings.ToLower(p.Name), query) {
+			continue
+		}
+		list = append(list, sdk.Playlist{ID: p.ID, UID: p.UID, Name: p.Name, Interval: p.Interval})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+	return http.StatusOK, list
+}
+
+func (s *Server) getPlaylist(r *request) (int, interface{}) {
+	p := r.org.playlists[r.params["uid"]]
+	if p == nil {
+		return http.StatusNotFound, message("Playlist not found")
+	}
+	return http.StatusOK, p
+}
+
+func (s *Server) getPlaylistItems(r *request) (int, interface{}) {
+	p := r.org.playlists[r.params["uid"]]
+	if p == nil {
+		return http.StatusNotFound, message("Playlist not found")
+	}
+	return http.StatusOK, p.Items
+}
+
+func (s *Server) createPlaylist(r *request) (int, interface{}) {
+	var in sdk.Playlist
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if strings.TrimSpace(in.Name) == "" {
+		return http.StatusBadRequest, message("Playlist name cannot be empty")
+	}
+	p := &sdk.Playlist{ID: s.nextID("playlist"), UID: in.UID, Name: in.Name, Interval: in.Interval}
+	if p.UID == "" {
+		p.UID = fmt.Sprintf("sdktest-playlist-%d", p.ID)
+	}
+	if r.org.playlists[p.UID] != nil {
+		return http.StatusBadRequest, message("Playlist with the same uid already exists")
+	}
+	if code, reply := setPlaylistItems(p, in.Items); reply != nil {
+		return code, reply
+	}
+	r.org.playlists[p.UID] = p
+	return http.StatusOK, p
+}
+
+func (s *Server) updatePlaylist(r *request) (int, interface{}) {
+	var in sdk.Playlist
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	p := r.org.playlists[r.params["uid"]]
+	if p == nil {
+		return http.StatusNotFound, message("Playlist not found")
+	}
+	updated := *p
+	updated.Name = in.Name
+	updated.Interval = in.Interval
+	if code, reply := setPlaylistItems(&updated, in.Items); reply != nil {
+		return code, reply
+	}
+	*p = updated
+	return http.StatusOK, p
+}
+
+func (s *Server) deletePlaylist(r *request) (int, interface{}) {
+	p := r.org.playlists[r.params["uid"]]
+	if p == nil {
+		return http.StatusNotFound, message("Playlist not found")
+	}
+	delete(r.org.playlists, p.UID)
+	return http.StatusOK, map[string]interface{}{}
+}
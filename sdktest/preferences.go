@@ -0,0 +1,135 @@
+package sdktest
+
+import (
+	"net/http"
+	"sort"
+)
+
+// publicPrefs returns the preferences as Grafana shows them, the cookie
+// consent is saved as cookies list but returned as cookiePreferences
+// object.
+func publicPrefs(prefs map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(prefs))
+	for k, v := range prefs {
+		out[k] = v
+	}
+	if list, ok := prefs["cookies"].([]interface{}); ok {
+		set := make(map[string]interface{}, len(list))
+		for _, name := range toStrings(list) {
+			set[name] = map[string]interface{}{}
+		}
+		delete(out, "cookies")
+		out["cookiePreferences"] = set
+	}
+	return out
+}
+
+// replacePrefs replaces the preferences with the ones of the request.
+func replacePrefs(r *request, prefs *map[string]interface{}) (int, interface{}) {
+	in := make(map[string]interface{})
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	*prefs = in
+	return http.StatusOK, message("Preferences updated")
+}
+
+// patchPrefs changes the preferences set in the request only.
+func patchPrefs(r *request, prefs map[string]interface{}) (int, interface{}) {
+	in := make(map[string]interface{})
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	for k, v := range in {
+		if v != nil {
+			prefs[k] = v
+		}
+	}
+	return http.StatusOK, message("Preferences updated")
+}
+
+// userPreferences returns preferences of the user in the organization.
+func (o *org) userPreferences(userID uint) map[string]interface{} {
+	prefs := o.userPrefs[userID]
+	if prefs == nil {
+		prefs = make(map[string]interface{})
+		o.userPrefs[userID] = prefs
+	}
+	return prefs
+}
+
+// effectivePrefs returns the preferences of the user overlaid on the
+// preferences of its teams and the organization.
+func (o *org) effectivePrefs(userID uint) map[string]interface{} {
+	out := make(map[string]interface{})
+	for k, v := range o.prefs {
+		out[k] = v
+	}
+	teams := make([]*team, 0, len(o.teams))
+	for _, t := range o.teams {
+		if t.members[userID] {
+			teams = append(teams, t)
+		}
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
+	for _, t := range teams {
+		for k, v := range t.prefs {
+			out[k] = v
+		}
+	}
+	for k, v := range o.userPrefs[userID] {
+		out[k] = v
+	}
+	return out
+}
+
+func (s *Server) getOrgPreferences(r *request) (int, interface{}) {
+	return http.StatusOK, publicPrefs(r.org.prefs)
+}
+
+func (s *Server) updateOrgPreferences(r *request) (int, interface{}) {
+	return replacePrefs(r, &r.org.prefs)
+}
+
+func (s *Server) patchOrgPreferences(r *request) (int, interface{}) {
+	return patchPrefs(r, r.org.prefs)
+}
+
+func (s *Server) getUserPreferences(r *request) (int, interface{}) {
+	return http.StatusOK, publicPrefs(r.org.userPreferences(r.user.ID))
+}
+
+func (s *Server) updateUserPreferences(r *request) (int, interface{}) {
+	prefs := r.org.userPreferences(r.user.ID)
+	code, reply := replacePrefs(r, &prefs)
+	r.org.userPrefs[r.user.ID] = prefs
+	return code, reply
+}
+
+func (s *Server) patchUserPreferences(r *request) (int, interface{}) {
+	return patchPrefs(r, r.org.userPreferences(r.user.ID))
+}
+
+func (s *Server) getTeamPreferences(r *request) (int, interface{}) {
+	t, code, reply := s.teamParam(r)
+	if t == nil {
+		return code, reply
+	}
+	return http.StatusOK, publicPrefs(t.prefs)
+}
+
+func (s *Server) updateTeamPreferences(r *request) (int, interface{}) {
+	t, code, reply := s.teamParam(r)
+	if t == nil {
+		return code, reply
+	}
+	return replacePrefs(r, &t.prefs)
+}
+
+func (s *Server) patchTeamPreferences(r *request) (int, interface{}) {
+	t, code, reply := s.teamParam(r)
+	if t == nil {
+		return code, reply
+	}
+	return patchPrefs(r, t.prefs)
+}
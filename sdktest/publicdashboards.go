@@ -0,0 +1,125 @@
+package sdktest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/bdunavant/sdk"
+)
+
+func (s *Server) getPublicDashboards(r *request) (int, interface{}) {
+	list := make([]sdk.FoundPublicDashboard, 0, len(r.org.publicDashboards))
+	for _, p := range r.org.publicDashboards {
+		found := sdk.FoundPublicDashboard{
+			UID:          p.UID,
+			AccessToken:  p.AccessToken,
+			DashboardUID: p.DashboardUID,
+			IsEnabled:    p.IsEnabled,
+		}
+		if d := r.org.dashboardByUID(p.DashboardUID); d != nil {
+			found.Title = d.title
+			found.Slug = d.slug
+		}
+		list = append(list, found)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Title < list[j].Title })
+	// the list is paged as Grafana 10.2 and above does
+	var (
+		q       = r.URL.Query()
+		perPage = 1000
+		page    = 1
+		result  = map[string]interface{}{"totalCount": len(list), "publicDashboards": []sdk.FoundPublicDashboard{}}
+	)
+	if v, err := strconv.Atoi(q.Get("perpage")); err == nil && v > 0 {
+		perPage = v
+	}
+	if v, err := strconv.Atoi(q.Get("page")); err == nil && v > 0 {
+		page = v
+	}
+	if start := (page - 1) * perPage; start < len(list) {
+		end := start + perPage
+		if end > len(list) {
+			end = len(list)
+		}
+		result["publicDashboards"] = list[start:end]
+	}
+	result["page"], result["perPage"] = page, perPage
+	return http.StatusOK, result
+}
+
+func (s *Server) getPublicDashboard(r *request) (int, interface{}) {
+	p := r.org.publicDashboards[r.params["dashboardUid"]]
+	if p == nil {
+		return http.StatusNotFound, message("Public dashboard not found")
+	}
+	return http.StatusOK, p
+}
+
+func (s *Server) createPublicDashboard(r *request) (int, interface{}) {
+	var in sdk.PublicDashboard
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	d := r.org.dashboardByUID(r.params["dashboardUid"])
+	if d == nil {
+		return http.StatusNotFound, message("Dashboard not found")
+	}
+	if r.org.publicDashboards[d.uid] != nil {
+		return http.StatusBadRequest, message("Public dashboard already exists")
+	}
+	if in.Share == "" {
+		in.Share = sdk.PublicDashboardSharePublic
+	}
+	if in.Share != sdk.PublicDashboardSharePublic && in.Share != sdk.PublicDashboardShareEmail {
+		return http.StatusBadRequest, message("Invalid share type")
+	}
+	id := s.nextID("publicDashboard")
+	if in.UID == "" {
+		in.UID = fmt.Sprintf("sdktest-public-%d", id)
+	}
+	if in.AccessToken == "" {
+		in.AccessToken = fmt.Sprintf("sdktest-token-%d", id)
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	in.DashboardUID = d.uid
+	in.CreatedBy = int(r.user.ID)
+	in.CreatedAt = &now
+	r.org.publicDashboards[d.uid] = &in
+	return http.StatusOK, in
+}
+
+func (s *Server) updatePublicDashboard(r *request) (int, interface{}) {
+	var in sdk.PublicDashboard
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	p := r.org.publicDashboards[r.params["dashboardUid"]]
+	if p == nil || p.UID != r.params["uid"] {
+		return http.StatusNotFound, message("Public dashboard not found")
+	}
+	if in.Share != "" && in.Share != sdk.PublicDashboardSharePublic && in.Share != sdk.PublicDashboardShareEmail {
+		return http.StatusBadRequest, message("Invalid share type")
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	p.IsEnabled = in.IsEnabled
+	p.TimeSelectionEnabled = in.TimeSelectionEnabled
+	p.AnnotationsEnabled = in.AnnotationsEnabled
+	if in.Share != "" {
+		p.Share = in.Share
+	}
+	p.UpdatedBy = int(r.user.ID)
+	p.UpdatedAt = &now
+	return http.StatusOK, p
+}
+
+func (s *Server) deletePublicDashboard(r *request) (int, interface{}) {
+	p := r.org.publicDashboards[r.params["dashboardUid"]]
+	if p == nil || p.UID != r.params["uid"] {
+		return http.StatusNotFound, message("Public dashboard not found")
+	}
+	delete(r.org.publicDashboards, p.DashboardUID)
+	return http.StatusOK, nil
+}
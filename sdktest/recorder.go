@@ -0,0 +1,367 @@
+package sdktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bdunavant/sdk"
+)
+
+// Mode of the Recorder.
+type Mode int
+
+const (
+	// ModeReplay answers requests with the responses from the cassette
+	// without network access.
+	ModeReplay Mode = iota
+	// ModeRecord passes requests to the real server and saves
+	// the exchanges to the cassette.
+	ModeRecord
+)
+
+// Scrubbed replaces values of secrets in the cassettes.
+const Scrubbed = "[SCRUBBED]"
+
+// DefaultScrubHeaders lists the headers that are never saved to
+// cassettes because they carry credentials or change on each run. Names
+// ending with "*" match headers by prefix. Headers reported by
+// sdk.IsSensitiveHeader are never saved either.
+var DefaultScrubHeaders = []string{
+	"Authorization", "Cookie", "Set-Cookie", "Date", "Content-Length", "X-Request-Id", "Grafana-*",
+}
+
+// DefaultScrubFields lists keys of JSON bodies whose values are replaced
+// with Scrubbed in cassettes.
+var DefaultScrubFields = []string{
+	"password", "basicAuthPassword", "secureJsonData", "oldPassword", "newPassword", "deleteKey", "deleteUrl",
+}
+
+// DefaultScrubURLFields lists keys of JSON bodies scrubbed only in the
+// exchanges with the URL paths matching the patterns, see path.Match.
+// The key field is the secret of API keys and service account tokens
+// but snapshots are identified by it, so the snapshot keys are kept.
+var DefaultScrubURLFields = map[string][]string{
+	"/api/auth/keys":                {"key"},
+	"/api/serviceaccounts/*/tokens": {"key"},
+}
+
+// Cassette keeps the recorded exchanges.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request with its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the saved form of the request. URL is saved
+// without the scheme, host and user credentials so cassettes
+// recorded against one server could be replayed for another.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is the saved form of the response.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Matcher reports whether the recorded request matches the actual one.
+// The actual request is already scrubbed the same way as the recorded
+// one.
+type Matcher func(actual, recorded RecordedRequest) bool
+
+// MatchMethodAndURL is the default Matcher. It compares methods, paths
+// and query parameters.
+func MatchMethodAndURL(actual, recorded RecordedRequest) bool {
+	if actual.Method != recorded.Method {
+		return false
+	}
+	a, err := url.Parse(actual.URL)
+	if err != nil {
+		return false
+	}
+	b, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	// Encode() sorts the parameters by keys
+	return a.Path == b.Path && a.Query().Encode() == b.Query().Encode()
+}
+
+// MatchBody compares methods, URLs and bodies of requests. Use it when
+// the same endpoint called with different payloads in a test.
+func MatchBody(actual, recorded RecordedRequest) bool {
+	return MatchMethodAndURL(actual, recorded) && actual.Body == recorded.Body
+}
+
+// Recorder is a http.RoundTripper that records exchanges to the cassette
+// file or replays them from it. Use it as the transport of the client:
+//
+//	rec, err := sdktest.NewRecorder("testdata/cassettes/dashboards.json", sdktest.ModeReplay)
+//	client := sdk.NewClientWithOptions(url, sdk.WithHTTPClient(&http.Client{Transport: rec}))
+//
+// In the record mode the cassette is rewritten after each exchange so it
+// stays complete even if the test fails in the middle. In the replay mode
+// each recorded interaction is used once in order of recording.
+type Recorder struct {
+	// Transport makes real requests in the record mode,
+	// http.DefaultTransport is used if it is nil.
+	Transport http.RoundTripper
+	// Matcher selects the interaction for replay, MatchMethodAndURL
+	// is used if it is nil.
+	Matcher Matcher
+	// ScrubHeaders, ScrubFields and ScrubURLFields are removed from
+	// the recorded exchanges. They are initialized with the defaults
+	// by NewRecorder.
+	ScrubHeaders   []string
+	ScrubFields    []string
+	ScrubURLFields map[string][]string
+
+	mode     Mode
+	path     string
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder creates a recorder for the cassette file. In the replay mode
+// the cassette must exist. In the record mode the existing cassette is
+// replaced.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	rec := &Recorder{
+		ScrubHeaders:   append([]string{}, DefaultScrubHeaders...),
+		ScrubFields:    append([]string{}, DefaultScrubFields...),
+		ScrubURLFields: make(map[string][]string, len(DefaultScrubURLFields)),
+		mode:           mode,
+		path:           path,
+	}
+	for pattern, fields := range DefaultScrubURLFields {
+		rec.ScrubURLFields[pattern] = append([]string{}, fields...)
+	}
+	if mode == ModeRecord {
+		return rec, nil
+	}
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(raw, &rec.cassette); err != nil {
+		return nil, fmt.Errorf("sdktest: invalid cassette %s: %s", path, err)
+	}
+	rec.used = make([]bool, len(rec.cassette.Interactions))
+	return rec, nil
+}
+
+// Mode returns the mode of the recorder.
+func (rec *Recorder) Mode() Mode {
+	return rec.mode
+}
+
+// Cassette returns a copy of the exchanges recorded or loaded so far.
+func (rec *Recorder) Cassette() Cassette {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return Cassette{Interactions: append([]Interaction{}, rec.cassette.Interactions...)}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rec *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		body []byte
+		err  error
+	)
+	if req.Body != nil {
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+	actual := RecordedRequest{
+		Method: req.Method,
+		URL:    req.URL.RequestURI(),
+		Header: rec.scrubHeader(req.Header),
+		Body:   rec.scrubBody(req.URL.Path, body),
+	}
+	if rec.mode == ModeReplay {
+		return rec.replay(req, actual)
+	}
+	return rec.record(req, body, actual)
+}
+
+func (rec *Recorder) replay(req *http.Request, actual RecordedRequest) (*http.Response, error) {
+	match := rec.Matcher
+	if match == nil {
+		match = MatchMethodAndURL
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	for i, it := range rec.cassette.Interactions {
+		if rec.used[i] || !match(actual, it.Request) {
+			continue
+		}
+		rec.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", it.Response.StatusCode, http.StatusText(it.Response.StatusCode)),
+			StatusCode:    it.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        it.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewBufferString(it.Response.Body)),
+			ContentLength: int64(len(it.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("sdktest: no recorded interaction for %s %s in %s", actual.Method, actual.URL, rec.path)
+}
+
+func (rec *Recorder) record(req *http.Request, body []byte, actual RecordedRequest) (*http.Response, error) {
+	transport := rec.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	out := req.Clone(req.Context())
+	if req.Body != nil {
+		out.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	resp, err := transport.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.cassette.Interactions = append(rec.cassette.Interactions, Interaction{
+		Request: actual,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     rec.scrubHeader(resp.Header),
+			Body:       rec.scrubBody(req.URL.Path, data),
+		},
+	})
+	if err = rec.save(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (rec *Recorder) save() error {
+	raw, err := json.MarshalIndent(rec.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(rec.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(rec.path, raw, 0644)
+}
+
+func (rec *Recorder) scrubHeader(h http.Header) http.Header {
+	out := h.Clone()
+	for name := range out {
+		if sdk.IsSensitiveHeader(name) {
+			delete(out, name)
+		}
+	}
+	for _, name := range rec.ScrubHeaders {
+		if prefix := strings.TrimSuffix(name, "*"); prefix != name {
+			prefix = http.CanonicalHeaderKey(prefix)
+			for k := range out {
+				if strings.HasPrefix(k, prefix) {
+					delete(out, k)
+				}
+			}
+			continue
+		}
+		out.Del(name)
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// scrubBody replaces the secret fields of JSON body of the exchange with
+// the URL path. Other bodies are saved as is.
+func (rec *Recorder) scrubBody(urlPath string, body []byte) string {
+	var plain interface{}
+	if len(body) == 0 {
+		return ""
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if dec.Decode(&plain) != nil || dec.More() {
+		return string(body)
+	}
+	fields := make(map[string]bool, len(rec.ScrubFields))
+	for _, f := range rec.ScrubFields {
+		fields[f] = true
+	}
+	for pattern, extra := range rec.ScrubURLFields {
+		if matchURLPath(pattern, urlPath) {
+			for _, f := range extra {
+				fields[f] = true
+			}
+		}
+	}
+	out, err := json.Marshal(scrubValue(plain, fields))
+	if err != nil {
+		return string(body)
+	}
+	return string(out)
+}
+
+func scrubValue(v interface{}, fields map[string]bool) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, field := range value {
+			if fields[k] {
+				value[k] = Scrubbed
+				continue
+			}
+			value[k] = scrubValue(field, fields)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = scrubValue(item, fields)
+		}
+	}
+	return v
+}
+
+// matchURLPath matches the pattern against the trailing segments of the
+// path, so the patterns work for Grafana served from a subpath.
+func matchURLPath(pattern, urlPath string) bool {
+	var (
+		want = strings.Split(strings.Trim(pattern, "/"), "/")
+		have = strings.Split(strings.Trim(urlPath, "/"), "/")
+	)
+	if len(have) < len(want) {
+		return false
+	}
+	ok, _ := path.Match(strings.Join(want, "/"), strings.Join(have[len(have)-len(want):], "/"))
+	return ok
+}
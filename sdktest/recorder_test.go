@@ -0,0 +1,174 @@
+package sdktest_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sdktest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "cassettes", "datasources.json")
+	ctx := context.Background()
+
+	srv := sdktest.NewServer(t)
+	rec, err := sdktest.NewRecorder(cassette, sdktest.ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := sdk.NewClientWithOptions(srv.URL,
+		sdk.WithBasicAuth(sdktest.AdminLogin, sdktest.AdminPassword),
+		sdk.WithHeader("X-Proxy-Token", "proxysecret"),
+		sdk.WithHeader("X-Request-Id", "req-1"),
+		sdk.WithHTTPClient(&http.Client{Transport: rec}))
+	ds := sdk.Datasource{Name: "pg", Type: "postgres", SecureJSONData: map[string]string{"password": "s3cret"}}
+	if _, err = client.CreateDatasource(ctx, ds); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.GetDatasourceByName(ctx, "pg"); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	raw, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "s3cret") || strings.Contains(string(raw), "Authorization") ||
+		strings.Contains(string(raw), "proxysecret") || strings.Contains(string(raw), "req-1") {
+		t.Errorf("secrets are not scrubbed from the cassette:\n%s", raw)
+	}
+	if n := len(rec.Cassette().Interactions); n != 2 {
+		t.Fatalf("expected 2 recorded interactions, got %d", n)
+	}
+
+	// the server is closed, the replay must not use network
+	rec, err = sdktest.NewRecorder(cassette, sdktest.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = sdk.NewClientWithOptions("http://grafana.invalid",
+		sdk.WithHTTPClient(&http.Client{Transport: rec}))
+	got, err := client.GetDatasourceByName(ctx, "pg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != "postgres" {
+		t.Errorf("unexpected replayed datasource %+v", got)
+	}
+	if _, err = client.GetDatasourceByName(ctx, "pg"); err == nil {
+		t.Error("expected error because the interaction is already replayed")
+	}
+}
+
+func TestRecorder_MatchBody(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sdktest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "folders.json")
+	ctx := context.Background()
+
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	rec, err := sdktest.NewRecorder(cassette, sdktest.ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := sdk.NewClientWithOptions(srv.URL,
+		sdk.WithBasicAuth(sdktest.AdminLogin, sdktest.AdminPassword),
+		sdk.WithHTTPClient(&http.Client{Transport: rec}))
+	for _, title := range []string{"First", "Second"} {
+		if _, err = client.CreateFolder(ctx, sdk.Folder{Title: title}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rec, err = sdktest.NewRecorder(cassette, sdktest.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.Matcher = sdktest.MatchBody
+	client = sdk.NewClientWithOptions(srv.URL, sdk.WithHTTPClient(&http.Client{Transport: rec}))
+	folder, err := client.CreateFolder(ctx, sdk.Folder{Title: "Second"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if folder.Title != "Second" {
+		t.Errorf("expected the second interaction to be replayed, got %q", folder.Title)
+	}
+	if _, err = client.CreateFolder(ctx, sdk.Folder{Title: "Third"}); err == nil {
+		t.Error("expected error for the request that was not recorded")
+	}
+}
+
+func TestRecorder_ScrubKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sdktest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cassette := filepath.Join(dir, "keys.json")
+	ctx := context.Background()
+
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	rec, err := sdktest.NewRecorder(cassette, sdktest.ModeRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := sdk.NewClientWithOptions(srv.URL,
+		sdk.WithBasicAuth(sdktest.AdminLogin, sdktest.AdminPassword),
+		sdk.WithHTTPClient(&http.Client{Transport: rec}))
+	snapshot, err := client.CreateSnapshot(ctx, sdk.CreateSnapshotRequest{Dashboard: *sdk.NewBoard("Incident")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = client.GetSnapshotByKey(ctx, snapshot.Key); err != nil {
+		t.Fatal(err)
+	}
+	sa, err := client.CreateServiceAccount(ctx, sdk.CreateServiceAccountRequest{Name: "ci"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := client.CreateServiceAccountToken(ctx, sa.ID, sdk.CreateServiceAccountTokenRequest{Name: "ci"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := ioutil.ReadFile(cassette)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), snapshot.DeleteKey) || strings.Contains(string(raw), token.Key) {
+		t.Errorf("secret keys are not scrubbed from the cassette:\n%s", raw)
+	}
+
+	rec, err = sdktest.NewRecorder(cassette, sdktest.ModeReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = sdk.NewClientWithOptions("http://grafana.invalid", sdk.WithHTTPClient(&http.Client{Transport: rec}))
+	replayed, err := client.CreateSnapshot(ctx, sdk.CreateSnapshotRequest{Dashboard: *sdk.NewBoard("Incident")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.Key != snapshot.Key {
+		t.Fatalf("expected the snapshot key kept, got %q", replayed.Key)
+	}
+	if _, _, err = client.GetSnapshotByKey(ctx, replayed.Key); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,165 @@
+package sdktest
+
+import "net/http"
+
+// allRoutes lists the endpoints implemented by the fake server. The routes
+// with literal path segments go before the ones with parameters in the same
+// position.
+func (s *Server) allRoutes() []route {
+	return []route{
+		newRoute("GET", "api/health", (*Server).getHealth),
+
+		newRoute("GET", "api/search", (*Server).search),
+		newRoute("GET", "api/dashboards/home", (*Server).getHomeDashboard),
+		newRoute("GET", "api/dashboards/tags", (*Server).getDashboardTags),
+		newRoute("GET", "api/dashboards/public-dashboards", (*Server).getPublicDashboards),
+		newRoute("GET", "api/dashboards/uid/:uid", (*Server).getDashboardByUID),
+		newRoute("GET", "api/dashboards/db/:slug", (*Server).getDashboardBySlug),
+		newRoute("GET", "api/dashboards/uid/:uid/versions", (*Server).getDashboardVersions),
+		newRoute("GET", "api/dashboards/uid/:uid/versions/:version", (*Server).getDashboardVersion),
+		newRoute("POST", "api/dashboards/uid/:uid/restore", (*Server).restoreDashboardVersion),
+		newRoute("GET", "api/dashboards/uid/:uid/permissions", (*Server).getDashboardPermissions),
+		newRoute("POST", "api/dashboards/uid/:uid/permissions", (*Server).updateDashboardPermissions),
+		newRoute("GET", "api/dashboards/uid/:dashboardUid/public-dashboards", (*Server).getPublicDashboard),
+		newRoute("POST", "api/dashboards/uid/:dashboardUid/public-dashboards", (*Server).createPublicDashboard),
+		newRoute("PATCH", "api/dashboards/uid/:dashboardUid/public-dashboards/:uid", (*Server).updatePublicDashboard),
+		newRoute("DELETE", "api/dashboards/uid/:dashboardUid/public-dashboards/:uid", (*Server).deletePublicDashboard),
+		newRoute("POST", "api/dashboards/db", (*Server).setDashboard),
+		newRoute("POST", "api/dashboards/import", (*Server).importDashboard),
+		newRoute("DELETE", "api/dashboards/uid/:uid", (*Server).deleteDashboardByUID),
+		newRoute("DELETE", "api/dashboards/db/:slug", (*Server).deleteDashboardBySlug),
+
+		newRoute("GET", "api/folders", (*Server).getAllFolders),
+		newRoute("GET", "api/folders/id/:id", (*Server).getFolderByID),
+		newRoute("GET", "api/folders/:uid", (*Server).getFolderByUID),
+		newRoute("GET", "api/folders/:uid/permissions", (*Server).getFolderPermissions),
+		newRoute("POST", "api/folders/:uid/permissions", (*Server).updateFolderPermissions),
+		newRoute("POST", "api/folders", (*Server).createFolder),
+		newRoute("PUT", "api/folders/:uid", (*Server).updateFolder),
+		newRoute("DELETE", "api/folders/:uid", (*Server).deleteFolder),
+
+		newRoute("GET", "api/library-elements", (*Server).searchLibraryElements),
+		newRoute("GET", "api/library-elements/:uid", (*Server).getLibraryElement),
+		newRoute("GET", "api/library-elements/:uid/connections", (*Server).getLibraryElementConnections),
+		newRoute("POST", "api/library-elements", (*Server).createLibraryElement),
+		newRoute("PATCH", "api/library-elements/:uid", (*Server).updateLibraryElement),
+		newRoute("DELETE", "api/library-elements/:uid", (*Server).deleteLibraryElement),
+
+		newRoute("GET", "api/playlists", (*Server).getPlaylists),
+		newRoute("GET", "api/playlists/:uid", (*Server).getPlaylist),
+		newRoute("GET", "api/playlists/:uid/items", (*Server).getPlaylistItems),
+		newRoute("POST", "api/playlists", (*Server).createPlaylist),
+		newRoute("PUT", "api/playlists/:uid", (*Server).updatePlaylist),
+		newRoute("DELETE", "api/playlists/:uid", (*Server).deletePlaylist),
+
+		newRoute("GET", "api/datasources", (*Server).getAllDatasources),
+		newRoute("GET", "api/datasources/plugins", (*Server).getDatasourceTypes),
+		newRoute("GET", "api/datasources/name/:name", (*Server).getDatasourceByName),
+		newRoute("GET", "api/datasources/:id", (*Server).getDatasource),
+		newRoute("POST", "api/datasources", (*Server).createDatasource),
+		newRoute("PUT", "api/datasources/:id", (*Server).updateDatasource),
+		newRoute("DELETE", "api/datasources/name/:name", (*Server).deleteDatasourceByName),
+		newRoute("DELETE", "api/datasources/:id", (*Server).deleteDatasource),
+		newRoute("POST", "api/ds/query", (*Server).queryDatasources),
+
+		newRoute("GET", "api/org", (*Server).getActualOrg),
+		newRoute("PUT", "api/org", (*Server).updateActualOrg),
+		newRoute("PUT", "api/org/address", (*Server).updateActualOrgAddress),
+		newRoute("GET", "api/org/preferences", (*Server).getOrgPreferences),
+		newRoute("PUT", "api/org/preferences", (*Server).updateOrgPreferences),
+		newRoute("PATCH", "api/org/preferences", (*Server).patchOrgPreferences),
+		newRoute("GET", "api/org/users", (*Server).getActualOrgUsers),
+		newRoute("POST", "api/org/users", (*Server).addActualOrgUser),
+		newRoute("POST", "api/org/users/:userId", (*Server).updateActualOrgUser),
+		newRoute("PATCH", "api/org/users/:userId", (*Server).updateActualOrgUser),
+		newRoute("DELETE", "api/org/users/:userId", (*Server).deleteActualOrgUser),
+		newRoute("GET", "api/orgs", (*Server).getAllOrgs),
+		newRoute("POST", "api/orgs", (*Server).createOrg),
+		newRoute("GET", "api/orgs/name/:name", (*Server).getOrgByName),
+		newRoute("GET", "api/orgs/:orgId", (*Server).getOrg),
+		newRoute("PUT", "api/orgs/:orgId", (*Server).updateOrg),
+		newRoute("DELETE", "api/orgs/:orgId", (*Server).deleteOrg),
+		newRoute("PUT", "api/orgs/:orgId/address", (*Server).updateOrgAddress),
+		newRoute("GET", "api/orgs/:orgId/users", (*Server).getOrgUsers),
+		newRoute("POST", "api/orgs/:orgId/users", (*Server).addOrgUser),
+		newRoute("PATCH", "api/orgs/:orgId/users/:userId", (*Server).updateOrgUser),
+		newRoute("DELETE", "api/orgs/:orgId/users/:userId", (*Server).deleteOrgUser),
+
+		newRoute("GET", "api/teams/search", (*Server).searchTeams),
+		newRoute("GET", "api/teams/:id", (*Server).getTeam),
+		newRoute("POST", "api/teams", (*Server).createTeam),
+		newRoute("PUT", "api/teams/:id", (*Server).updateTeam),
+		newRoute("DELETE", "api/teams/:id", (*Server).deleteTeam),
+		newRoute("GET", "api/teams/:id/members", (*Server).getTeamMembers),
+		newRoute("POST", "api/teams/:id/members", (*Server).addTeamMember),
+		newRoute("DELETE", "api/teams/:id/members/:userId", (*Server).removeTeamMember),
+		newRoute("GET", "api/teams/:id/preferences", (*Server).getTeamPreferences),
+		newRoute("PUT", "api/teams/:id/preferences", (*Server).updateTeamPreferences),
+		newRoute("PATCH", "api/teams/:id/preferences", (*Server).patchTeamPreferences),
+
+		newRoute("GET", "api/user", (*Server).getActualUser),
+		newRoute("POST", "api/user/using/:orgId", (*Server).switchActualUserContext),
+		newRoute("GET", "api/user/preferences", (*Server).getUserPreferences),
+		newRoute("PUT", "api/user/preferences", (*Server).updateUserPreferences),
+		newRoute("PATCH", "api/user/preferences", (*Server).patchUserPreferences),
+		newRoute("POST", "api/user/stars/dashboard/:dashboardId", (*Server).starDashboard),
+		newRoute("DELETE", "api/user/stars/dashboard/:dashboardId", (*Server).unstarDashboard),
+		newRoute("POST", "api/user/stars/dashboard/uid/:uid", (*Server).starDashboard),
+		newRoute("DELETE", "api/user/stars/dashboard/uid/:uid", (*Server).unstarDashboard),
+		newRoute("GET", "api/users", (*Server).getAllUsers),
+		newRoute("GET", "api/users/search", (*Server).searchUsers),
+		newRoute("GET", "api/users/:userId", (*Server).getUser),
+		newRoute("POST", "api/users/:userId/using/:orgId", (*Server).switchUserContext),
+		newRoute("POST", "api/admin/users", (*Server).createUser),
+		newRoute("PUT", "api/admin/users/:userId/permissions", (*Server).updateUserPermissions),
+		newRoute("PUT", "api/admin/users/:userId/password", (*Server).updateUserPassword),
+		newRoute("DELETE", "api/admin/users/:userId", (*Server).deleteUser),
+		newRoute("POST", "api/admin/users/:userId/disable", (*Server).disableUser),
+		newRoute("POST", "api/admin/users/:userId/enable", (*Server).enableUser),
+		newRoute("GET", "api/admin/users/:userId/auth-tokens", (*Server).getUserAuthTokens),
+		newRoute("POST", "api/admin/users/:userId/revoke-auth-token", (*Server).revokeUserAuthToken),
+		newRoute("POST", "api/admin/users/:userId/logout", (*Server).logoutUser),
+		newRoute("GET", "api/admin/settings", (*Server).getSettings),
+		newRoute("GET", "api/admin/stats", (*Server).getStats),
+		newRoute("POST", "api/admin/provisioning/:kind/reload", (*Server).reloadProvisioning),
+
+		newRoute("GET", "api/annotations", (*Server).getAnnotations),
+		newRoute("POST", "api/annotations", (*Server).createAnnotation),
+		newRoute("PATCH", "api/annotations/:id", (*Server).patchAnnotation),
+		newRoute("DELETE", "api/annotations/:id", (*Server).deleteAnnotation),
+
+		newRoute("GET", "api/alert-notifications", (*Server).getAllAlertNotifications),
+		newRoute("GET", "api/alert-notifications/uid/:uid", (*Server).getAlertNotificationByUID),
+		newRoute("GET", "api/alert-notifications/:id", (*Server).getAlertNotificationByID),
+		newRoute("POST", "api/alert-notifications", (*Server).createAlertNotification),
+		newRoute("PUT", "api/alert-notifications/uid/:uid", (*Server).updateAlertNotificationByUID),
+		newRoute("PUT", "api/alert-notifications/:id", (*Server).updateAlertNotificationByID),
+		newRoute("DELETE", "api/alert-notifications/uid/:uid", (*Server).deleteAlertNotificationByUID),
+		newRoute("DELETE", "api/alert-notifications/:id", (*Server).deleteAlertNotificationByID),
+
+		newRoute("GET", "api/dashboard/snapshots", (*Server).getSnapshots),
+		newRoute("GET", "api/snapshots/:key", (*Server).getSnapshot),
+		newRoute("GET", "api/snapshots-delete/:deleteKey", (*Server).deleteSnapshotByDeleteKey),
+		newRoute("POST", "api/snapshots", (*Server).createSnapshot),
+		newRoute("DELETE", "api/snapshots/:key", (*Server).deleteSnapshot),
+
+		newRoute("GET", "api/serviceaccounts/search", (*Server).searchServiceAccounts),
+		newRoute("POST", "api/serviceaccounts/migrate", (*Server).migrateAPIKeys),
+		newRoute("POST", "api/serviceaccounts/migrate/:keyId", (*Server).migrateAPIKey),
+		newRoute("POST", "api/serviceaccounts", (*Server).createServiceAccount),
+		newRoute("GET", "api/serviceaccounts/:id", (*Server).getServiceAccount),
+		newRoute("PATCH", "api/serviceaccounts/:id", (*Server).updateServiceAccount),
+		newRoute("DELETE", "api/serviceaccounts/:id", (*Server).deleteServiceAccount),
+		newRoute("GET", "api/serviceaccounts/:id/tokens", (*Server).getServiceAccountTokens),
+		newRoute("POST", "api/serviceaccounts/:id/tokens", (*Server).createServiceAccountToken),
+		newRoute("DELETE", "api/serviceaccounts/:id/tokens/:tokenId", (*Server).deleteServiceAccountToken),
+	}
+}
+
+func (s *Server) getHealth(r *request) (int, interface{}) {
+	return http.StatusOK, map[string]string{
+		"commit":   "sdktest",
+		"database": "ok",
+		"version":  s.Version,
+	}
+}
@@ -0,0 +1,311 @@
+// Package sdktest provides an in-memory fake of Grafana HTTP API for unit
+// testing of the code that uses sdk.Client without a real Grafana instance.
+//
+// The fake implements the endpoints called by the sdk package. It keeps
+// the state in memory separately for each organization, enforces versions
+// of dashboards and folders and allows to inject faults:
+//
+//	srv := sdktest.NewServer(t)
+//	defer srv.Close()
+//	srv.AddFault(sdktest.Fault{Method: "GET", Path: "/api/search", StatusCode: 503, Times: 1})
+//	boards, err := srv.Client.Search(ctx)
+//
+// For tests against the exchanges captured from a real Grafana see
+// Recorder.
+package sdktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bdunavant/sdk"
+)
+
+// Credentials of the Grafana admin pre-created by NewServer.
+const (
+	AdminLogin    = "admin"
+	AdminPassword = "admin"
+)
+
+// Grafana versions reported by the fake server. DefaultVersion supports
+// the library panels, service accounts and public dashboards implemented
+// by the fake. LegacyVersion is for tests of the dashboard slug API and
+// other calls removed from recent versions, see Server.Version.
+const (
+	DefaultVersion = "10.4.0"
+	LegacyVersion  = "7.5.0"
+)
+
+// Server is the fake Grafana server.
+type Server struct {
+	// URL of the server in form http://ipaddr:port without trailing slash.
+	URL string
+	// Client is ready to use client for the server authenticated as
+	// the Grafana admin.
+	Client *sdk.Client
+	// Version of Grafana reported by /api/health. Clients detect
+	// capabilities of the server by it so it should be set before
+	// the first request.
+	Version string
+
+	t         testing.TB
+	ts        *httptest.Server
+	routes    []route
+	closeOnce sync.Once
+
+	mu     sync.Mutex
+	closed bool
+	faults []*Fault
+	ids    map[string]uint
+	admin  *user
+	orgs   map[uint]*org
+	users  map[uint]*user
+}
+
+// Fault describes the error the server answers to the matched requests.
+type Fault struct {
+	// Method of requests to fail, empty value matches any method.
+	Method string
+	// Path prefix of requests to fail, empty value matches any path.
+	Path string
+	// StatusCode and Body of the reply. Body is sent as a message of
+	// JSON reply, "fault injected by sdktest" is used if it is empty.
+	StatusCode int
+	Body       string
+	// Times limits the number of failed requests, zero means
+	// the fault is permanent.
+	Times int
+}
+
+type route struct {
+	method  string
+	pattern []string
+	handler func(s *Server, r *request) (int, interface{})
+}
+
+// request keeps parsed data of the incoming request.
+type request struct {
+	*http.Request
+	params map[string]string
+	org    *org
+	user   *user
+}
+
+// NewServer starts the fake server. The server is stopped with Close when
+// the test finishes, Go before 1.14 has no test cleanups so Close must be
+// called there explicitly. The server reports DefaultVersion, set Version
+// right after NewServer to test the client against other versions:
+//
+//	srv := sdktest.NewServer(t)
+//	srv.Version = sdktest.LegacyVersion
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		Version: DefaultVersion,
+		t:       t,
+		ids:     make(map[string]uint),
+		orgs:    make(map[uint]*org),
+		users:   make(map[uint]*user),
+	}
+	s.routes = s.allRoutes()
+	mainOrg := s.newOrg("Main Org.")
+	s.admin = &user{
+		User: sdk.User{
+			ID:             s.nextID("user"),
+			Login:          AdminLogin,
+			Name:           AdminLogin,
+			Email:          "admin@localhost",
+			OrgID:          mainOrg.ID,
+			IsGrafanaAdmin: true,
+		},
+		password: AdminPassword,
+	}
+	s.users[s.admin.ID] = s.admin
+	mainOrg.users[s.admin.ID] = "Admin"
+	s.ts = httptest.NewServer(s)
+	s.URL = s.ts.URL
+	s.Client = sdk.NewClientWithOptions(s.URL,
+		sdk.WithHTTPClient(s.ts.Client()),
+		sdk.WithBasicAuth(AdminLogin, AdminPassword))
+	if c, ok := t.(interface{ Cleanup(func()) }); ok {
+		c.Cleanup(s.Close)
+	}
+	return s
+}
+
+// Close shuts down the server. It is safe to call it more than once.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+		s.ts.Close()
+	})
+}
+
+// AddFault registers the fault. Faults are checked in the order they
+// were added, the first matched one is applied.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all registered faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f := s.matchFault(r); f != nil {
+		body := f.Body
+		if body == "" {
+			body = "fault injected by sdktest"
+		}
+		writeJSON(w, f.StatusCode, message("%s", body))
+		return
+	}
+	segments := splitPath(r.URL.Path)
+	for _, rt := range s.routes {
+		params, ok := rt.match(r.Method, segments)
+		if !ok {
+			continue
+		}
+		req, code, reply := s.authorize(r, params)
+		if req != nil {
+			code, reply = rt.handler(s, req)
+		}
+		writeJSON(w, code, reply)
+		return
+	}
+	// the test could be already finished when the server is closing
+	if !s.closed {
+		s.t.Logf("sdktest: unsupported request %s %s", r.Method, r.URL.Path)
+	}
+	writeJSON(w, http.StatusNotFound, message("Not found"))
+}
+
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// authorize finds the user and the organization of the request.
+func (s *Server) authorize(r *http.Request, params map[string]string) (*request, int, interface{}) {
+	req := &request{Request: r, params: params}
+	login, password, ok := r.BasicAuth()
+	if !ok {
+		// API keys and anonymous requests act as the admin
+		req.user = s.admin
+	} else {
+		req.user = s.userByLoginOrEmail(login)
+		if req.user == nil || req.user.password != password {
+			return nil, http.StatusUnauthorized, message("Invalid username or password")
+		}
+		if req.user.IsDisabled {
+			return nil, http.StatusUnauthorized, message("User is disabled")
+		}
+		s.openSession(req.user, r)
+	}
+	orgID := req.user.OrgID
+	if header := r.Header.Get("X-Grafana-Org-Id"); header != "" {
+		id, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			return nil, http.StatusBadRequest, message("Invalid org id")
+		}
+		orgID = uint(id)
+	}
+	req.org = s.orgs[orgID]
+	if req.org == nil {
+		return nil, http.StatusUnauthorized, message("Organization not found")
+	}
+	if _, member := req.org.users[req.user.ID]; !member && !req.user.IsGrafanaAdmin {
+		return nil, http.StatusUnauthorized, message("User is not a member of the organization")
+	}
+	return req, 0, nil
+}
+
+// nextID returns the next value of the sequence of identifiers for
+// the kind of objects.
+func (s *Server) nextID(kind string) uint {
+	s.ids[kind]++
+	return s.ids[kind]
+}
+
+func (rt route) match(method string, segments []string) (map[string]string, bool) {
+	if rt.method != method || len(rt.pattern) != len(segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, p := range rt.pattern {
+		if strings.HasPrefix(p, ":") {
+			params[p[1:]] = segments[i]
+			continue
+		}
+		if p != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func newRoute(method, pattern string, handler func(s *Server, r *request) (int, interface{})) route {
+	return route{method: method, pattern: splitPath(pattern), handler: handler}
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// uintParam returns the path parameter parsed as unsigned integer,
+// zero is returned for invalid values.
+func (r *request) uintParam(name string) uint {
+	v, _ := strconv.ParseUint(r.params[name], 10, 64)
+	return uint(v)
+}
+
+// decode reads JSON body of the request.
+func (r *request) decode(v interface{}) error {
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func message(format string, args ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"message": fmt.Sprintf(format, args...)}
+}
+
+func badRequest(err error) (int, interface{}) {
+	return http.StatusBadRequest, message("bad request data: %s", err)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
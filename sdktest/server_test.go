@@ -0,0 +1,249 @@
+package sdktest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestServer_Dashboards(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	srv.Version = sdktest.LegacyVersion
+	ctx := context.Background()
+	client := srv.Client
+
+	folder, err := client.CreateFolder(ctx, sdk.Folder{Title: "Team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	board := sdk.NewBoard("Service health")
+	board.ID = 0
+	board.UID = "health"
+	board.Tags = []string{"prod"}
+	resp, err := client.SetDashboard(ctx, *board, sdk.SetDashboardParams{FolderID: folder.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.UID != "health" || resp.Version != 1 {
+		t.Fatalf("unexpected response %s version %d", resp.UID, resp.Version)
+	}
+
+	// saving without the actual version is rejected
+	if _, err = client.SetDashboard(ctx, *board, sdk.SetDashboardParams{FolderID: folder.ID}); !errors.Is(err, sdk.ErrVersionMismatch) {
+		t.Fatalf("expected version mismatch, got %v", err)
+	}
+	board.Version = 1
+	if resp, err = client.SetDashboard(ctx, *board, sdk.SetDashboardParams{FolderID: folder.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Version != 2 {
+		t.Errorf("expected version 2, got %d", resp.Version)
+	}
+
+	// the same title in the folder requires overwrite
+	other := sdk.NewBoard("Service health")
+	other.ID = 0
+	if _, err = client.SetDashboard(ctx, *other, sdk.SetDashboardParams{FolderID: folder.ID}); !errors.Is(err, sdk.ErrNameExists) {
+		t.Fatalf("expected name-exists error, got %v", err)
+	}
+	if _, err = client.SetDashboard(ctx, *other, sdk.SetDashboardParams{FolderID: folder.ID, Overwrite: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, meta, err := client.GetDashboardByUID(ctx, "health")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Title != "Service health" || meta.Version != 3 || meta.FolderID != folder.ID || meta.FolderTitle != "Team" {
+		t.Errorf("unexpected dashboard %q with meta %+v", loaded.Title, meta)
+	}
+	if _, _, err = client.GetDashboardBySlug(ctx, "service-health"); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := client.Search(ctx, sdk.SearchTag("prod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 0 {
+		t.Errorf("expected the tags to be overwritten, found %v", found)
+	}
+	found, err = client.Search(ctx, sdk.SearchQuery("health"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0].UID != "health" || found[0].FolderUID != folder.UID {
+		t.Errorf("unexpected search result %v", found)
+	}
+
+	if _, err = client.DeleteFolderByUID(ctx, folder.UID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.DeleteDashboardByUID(ctx, "health"); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected dashboard to be deleted with its folder, got %v", err)
+	}
+}
+
+func TestServer_SearchPaging(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	for _, title := range []string{"A", "B", "C"} {
+		if _, err := srv.Client.SetRawDashboard(ctx, []byte(`{"title":"`+title+`"}`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	found, err := srv.Client.Search(ctx, sdk.SearchLimit(2), sdk.SearchPage(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0].Title != "C" {
+		t.Errorf("unexpected second page %v", found)
+	}
+}
+
+func TestServer_Orgs(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	st, err := client.CreateOrg(ctx, sdk.Org{Name: "Second"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.CreateOrg(ctx, sdk.Org{Name: "Second"}); !errors.Is(err, sdk.ErrConflict) {
+		t.Fatalf("expected conflict, got %v", err)
+	}
+	second := client.WithOrg(*st.OrgID)
+	if _, err = second.CreateDatasource(ctx, sdk.Datasource{Name: "prom", Type: "prometheus"}); err != nil {
+		t.Fatal(err)
+	}
+	if ds, err := client.GetAllDatasources(ctx); err != nil || len(ds) != 0 {
+		t.Errorf("expected datasources to be isolated per org, got %v, %v", ds, err)
+	}
+	if ds, err := second.GetDatasourceByName(ctx, "prom"); err != nil || ds.OrgID != *st.OrgID {
+		t.Errorf("expected datasource in the second org, got %+v, %v", ds, err)
+	}
+
+	user, err := client.CreateUser(ctx, sdk.User{Login: "viewer", Email: "viewer@localhost", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.AddOrgUser(ctx, sdk.UserRole{LoginOrEmail: "viewer", Role: "Editor"}, *st.OrgID); err != nil {
+		t.Fatal(err)
+	}
+	users, err := client.GetOrgUsers(ctx, *st.OrgID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 || users[1].ID != *user.ID || users[1].Role != "Editor" {
+		t.Errorf("unexpected org users %+v", users)
+	}
+	viewer := sdk.NewClientWithOptions(srv.URL, sdk.WithBasicAuth("viewer", "secret"))
+	if _, err = viewer.SwitchActualUserContext(ctx, *st.OrgID); err != nil {
+		t.Fatal(err)
+	}
+	if org, err := viewer.GetActualOrg(ctx); err != nil || org.Name != "Second" {
+		t.Errorf("expected the user to be switched to the second org, got %+v, %v", org, err)
+	}
+	bad := sdk.NewClientWithOptions(srv.URL, sdk.WithBasicAuth("viewer", "wrong"))
+	if _, err = bad.GetActualUser(ctx); !errors.Is(err, sdk.ErrUnauthorized) {
+		t.Errorf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestServer_AnnotationsAndAlertNotifications(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	client := srv.Client
+
+	now := time.Now()
+	for i, tag := range []string{"deploy", "incident"} {
+		_, err := client.CreateAnnotation(ctx, sdk.CreateAnnotationRequest{
+			Time: now.Add(time.Duration(i)*time.Minute).UnixNano() / int64(time.Millisecond),
+			Tags: []string{tag},
+			Text: tag,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	list, err := client.GetAnnotations(ctx, sdk.WithTag("incident"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Text != "incident" {
+		t.Errorf("unexpected annotations %+v", list)
+	}
+	if _, err = client.DeleteAnnotation(ctx, list[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := client.CreateAlertNotification(ctx, sdk.AlertNotification{Name: "email", Type: "email", UID: "email"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if an, err := client.GetAlertNotificationID(ctx, uint(id)); err != nil || an.UID != "email" {
+		t.Errorf("unexpected alert notification %+v, %v", an, err)
+	}
+	if err = client.DeleteAlertNotificationUID(ctx, "email"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.GetAlertNotificationUID(ctx, "email"); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestServer_Faults(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	ctx := context.Background()
+	srv.AddFault(sdktest.Fault{Method: "GET", Path: "/api/health", StatusCode: http.StatusServiceUnavailable, Times: 2})
+
+	if _, err := srv.Client.GetHealth(ctx); err == nil {
+		t.Fatal("expected injected fault")
+	}
+	client := srv.Client.WithOrg(0)
+	client.SetRetryPolicy(sdk.RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond})
+	health, err := client.GetHealth(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if health.Version != sdktest.DefaultVersion {
+		t.Errorf("unexpected version %s", health.Version)
+	}
+}
+
+func TestServer_Snapshot(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	resp, err := srv.Client.CreateSnapshot(context.Background(), sdk.CreateSnapshotRequest{Dashboard: *sdk.NewBoard("Snap")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.URL == "" {
+		t.Errorf("expected snapshot url in the response")
+	}
+}
+
+func TestServer_ClosedOnCleanup(t *testing.T) {
+	var srv *sdktest.Server
+	t.Run("test", func(t *testing.T) {
+		srv = sdktest.NewServer(t)
+		if _, err := srv.Client.GetHealth(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if _, err := srv.Client.GetHealth(context.Background()); err == nil {
+		t.Error("expected the server stopped when the test finished")
+	}
+	srv.Close()
+}
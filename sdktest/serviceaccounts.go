@@ -0,0 +1,215 @@
+package sdktest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bdunavant/sdk"
+)
+
+type serviceAccount struct {
+	sdk.ServiceAccount
+	tokens map[uint]*sdk.ServiceAccountToken
+}
+
+func (s *Server) serviceAccountParam(r *request) (*serviceAccount, int, interface{}) {
+	sa := r.org.serviceAccounts[r.uintParam("id")]
+	if sa == nil {
+		return nil, http.StatusNotFound, message("Service account not found")
+	}
+	return sa, 0, nil
+}
+
+func (o *org) serviceAccountByName(name string) *serviceAccount {
+	for _, sa := range o.serviceAccounts {
+		if sa.Name == name {
+			return sa
+		}
+	}
+	return nil
+}
+
+func (s *Server) searchServiceAccounts(r *request) (int, interface{}) {
+	var (
+		q       = r.URL.Query()
+		query   = strings.ToLower(q.Get("query"))
+		perPage = 1000
+		page    = 1
+		found   = []sdk.ServiceAccount{}
+	)
+	if v, err := strconv.Atoi(q.Get("perpage")); err == nil && v > 0 {
+		perPage = v
+	}
+	if v, err := strconv.Atoi(q.Get("page")); err == nil && v > 0 {
+		page = v
+	}
+	for _, sa := range r.org.serviceAccounts {
+		if query == "" ||
+			strings.Contains(strings.ToLower(sa.Name), query) ||
+			strings.Contains(strings.ToLower(sa.Login), query) {
+			found = append(found, sa.ServiceAccount)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].ID < found[j].ID })
+	result := sdk.PageServiceAccounts{TotalCount: len(found), Page: page, PerPage: perPage, ServiceAccounts: []sdk.ServiceAccount{}}
+	if start := (page - 1) * perPage; start < len(found) {
+		end := start + perPage
+		if end > len(found) {
+			end = len(found)
+		}
+		result.ServiceAccounts = found[start:end]
+	}
+	return http.StatusOK, result
+}
+
+func (s *Server) createServiceAccount(r *request) (int, interface{}) {
+	var in sdk.CreateServiceAccountRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if in.Name == "" {
+		return http.StatusBadRequest, message("Service account name is required")
+	}
+	if r.org.serviceAccountByName(in.Name) != nil {
+		return http.StatusConflict, message("service account already exists")
+	}
+	if in.Role == "" {
+		in.Role = "Viewer"
+	}
+	id := s.nextID("user")
+	sa := &serviceAccount{
+		ServiceAccount: sdk.ServiceAccount{
+			ID:         id,
+			UID:        fmt.Sprintf("sdktest-sa-%d", id),
+			OrgID:      r.org.ID,
+			Name:       in.Name,
+			Login:      "sa-" + makeSlug(in.Name),
+			Role:       in.Role,
+			IsDisabled: in.IsDisabled,
+		},
+		tokens: make(map[uint]*sdk.ServiceAccountToken),
+	}
+	r.org.serviceAccounts[id] = sa
+	return http.StatusCreated, sa.ServiceAccount
+}
+
+func (s *Server) getServiceAccount(r *request) (int, interface{}) {
+	sa, code, reply := s.serviceAccountParam(r)
+	if sa == nil {
+		return code, reply
+	}
+	return http.StatusOK, sa.ServiceAccount
+}
+
+func (s *Server) updateServiceAccount(r *request) (int, interface{}) {
+	var in sdk.UpdateServiceAccountRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	sa, code, reply := s.serviceAccountParam(r)
+	if sa == nil {
+		return code, reply
+	}
+	if in.Name != nil {
+		if other := r.org.serviceAccountByName(*in.Name); other != nil && other != sa {
+			return http.StatusConflict, message("service account already exists")
+		}
+		sa.Name = *in.Name
+	}
+	if in.Role != nil {
+		sa.Role = *in.Role
+	}
+	if in.IsDisabled != nil {
+		sa.IsDisabled = *in.IsDisabled
+	}
+	return http.StatusOK, map[string]interface{}{
+		"id":             sa.ID,
+		"name":           sa.Name,
+		"message":        "Service account updated",
+		"serviceaccount": sa.ServiceAccount,
+	}
+}
+
+func (s *Server) deleteServiceAccount(r *request) (int, interface{}) {
+	sa, code, reply := s.serviceAccountParam(r)
+	if sa == nil {
+		return code, reply
+	}
+	delete(r.org.serviceAccounts, sa.ID)
+	return http.StatusOK, message("Service account deleted")
+}
+
+func (s *Server) getServiceAccountTokens(r *request) (int, interface{}) {
+	sa, code, reply := s.serviceAccountParam(r)
+	if sa == nil {
+		return code, reply
+	}
+	tokens := make([]sdk.ServiceAccountToken, 0, len(sa.tokens))
+	for _, t := range sa.tokens {
+		token := *t
+		if token.Expiration != nil {
+			left := time.Until(*token.Expiration).Seconds()
+			token.SecondsUntilExpiration = &left
+			token.HasExpired = left <= 0
+		}
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].ID < tokens[j].ID })
+	return http.StatusOK, tokens
+}
+
+func (s *Server) createServiceAccountToken(r *request) (int, interface{}) {
+	var in sdk.CreateServiceAccountTokenRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	sa, code, reply := s.serviceAccountParam(r)
+	if sa == nil {
+		return code, reply
+	}
+	for _, t := range sa.tokens {
+		if t.Name == in.Name {
+			return http.StatusConflict, message("service account token with given name already exists in the organization")
+		}
+	}
+	now := time.Now().UTC()
+	token := &sdk.ServiceAccountToken{ID: s.nextID("token"), Name: in.Name, Created: &now}
+	if in.SecondsToLive > 0 {
+		expiration := now.Add(time.Duration(in.SecondsToLive) * time.Second)
+		token.Expiration = &expiration
+	}
+	sa.tokens[token.ID] = token
+	sa.Tokens = int64(len(sa.tokens))
+	return http.StatusOK, sdk.NewServiceAccountToken{
+		ID:   token.ID,
+		Name: token.Name,
+		Key:  fmt.Sprintf("glsa_sdktest_%d_%d", sa.ID, token.ID),
+	}
+}
+
+func (s *Server) deleteServiceAccountToken(r *request) (int, interface{}) {
+	sa, code, reply := s.serviceAccountParam(r)
+	if sa == nil {
+		return code, reply
+	}
+	id := r.uintParam("tokenId")
+	if sa.tokens[id] == nil {
+		return http.StatusNotFound, message("Service account token not found")
+	}
+	delete(sa.tokens, id)
+	sa.Tokens = int64(len(sa.tokens))
+	return http.StatusOK, message("Service account token deleted")
+}
+
+// migrateAPIKeys reports empty migration, the fake server has no API keys.
+func (s *Server) migrateAPIKeys(r *request) (int, interface{}) {
+	return http.StatusOK, sdk.ServiceAccountMigrationResult{}
+}
+
+func (s *Server) migrateAPIKey(r *request) (int, interface{}) {
+	return http.StatusNotFound, message("API key not found")
+}
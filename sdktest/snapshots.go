@@ -0,0 +1,151 @@
+package sdktest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bdunavant/sdk"
+)
+
+type snapshot struct {
+	id        uint
+	key       string
+	deleteKey string
+	name      string
+	userID    uint
+	external  bool
+	dashboard map[string]interface{}
+	created   time.Time
+	expires   time.Time
+}
+
+func (s *Server) createSnapshot(r *request) (int, interface{}) {
+	var in struct {
+		Dashboard map[string]interface{} `json:"dashboard"`
+		Name      string                 `json:"name"`
+		Expires   int64                  `json:"expires"`
+		External  bool                   `json:"external"`
+		Key       string                 `json:"key"`
+		DeleteKey string                 `json:"deleteKey"`
+	}
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if in.Dashboard == nil {
+		return http.StatusBadRequest, message("Dashboard is required")
+	}
+	sn := &snapshot{
+		id:        s.nextID("snapshot"),
+		key:       in.Key,
+		deleteKey: in.DeleteKey,
+		name:      in.Name,
+		userID:    r.user.ID,
+		external:  in.External,
+		dashboard: in.Dashboard,
+		created:   time.Now().UTC().Truncate(time.Second),
+	}
+	if sn.key == "" {
+		sn.key = fmt.Sprintf("sdktest-snapshot-%d", sn.id)
+	}
+	if sn.deleteKey == "" {
+		sn.deleteKey = fmt.Sprintf("sdktest-delete-%d", sn.id)
+	}
+	if sn.name == "" {
+		sn.name, _ = in.Dashboard["title"].(string)
+	}
+	if in.Expires > 0 {
+		sn.expires = sn.created.Add(time.Duration(in.Expires) * time.Second)
+	}
+	r.org.snapshots[sn.key] = sn
+	return http.StatusOK, map[string]interface{}{
+		"id":        sn.id,
+		"key":       sn.key,
+		"deleteKey": sn.deleteKey,
+		"url":       s.URL + "/dashboard/snapshot/" + sn.key,
+		"deleteUrl": s.URL + "/api/snapshots-delete/" + sn.deleteKey,
+	}
+}
+
+func (sn *snapshot) expired() bool {
+	return !sn.expires.IsZero() && time.Now().After(sn.expires)
+}
+
+func (s *Server) getSnapshots(r *request) (int, interface{}) {
+	var (
+		q        = r.URL.Query()
+		query    = strings.ToLower(q.Get("query"))
+		limit, _ = strconv.Atoi(q.Get("limit"))
+		list     = []sdk.Snapshot{}
+	)
+	for _, sn := range r.org.snapshots {
+		if query != "" && !strings.Contains(strings.ToLower(sn.name), query) {
+			continue
+		}
+		list = append(list, sdk.Snapshot{
+			ID:       sn.id,
+			Name:     sn.name,
+			Key:      sn.key,
+			OrgID:    r.org.ID,
+			UserID:   sn.userID,
+			External: sn.external,
+			Expires:  sn.expires,
+			Created:  sn.created,
+			Updated:  sn.created,
+		})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+	return http.StatusOK, list
+}
+
+func (s *Server) getSnapshot(r *request) (int, interface{}) {
+	sn := r.org.snapshots[r.params["key"]]
+	if sn == nil || sn.expired() {
+		return http.StatusNotFound, message("Dashboard snapshot not found")
+	}
+	return http.StatusOK, map[string]interface{}{
+		"dashboard": sn.dashboard,
+		"meta": map[string]interface{}{
+			"isSnapshot": true,
+			"type":       "snapshot",
+			"canSave":    false,
+			"canEdit":    false,
+			"canStar":    false,
+			"slug":       "",
+			"created":    sn.created,
+			"updated":    sn.created,
+			"expires":    sn.expires,
+		},
+	}
+}
+
+func (s *Server) deleteSnapshot(r *request) (int, interface{}) {
+	sn := r.org.snapshots[r.params["key"]]
+	if sn == nil {
+		return http.StatusNotFound, message("Failed to get dashboard snapshot")
+	}
+	return s.removeSnapshot(r.org, sn)
+}
+
+func (s *Server) deleteSnapshotByDeleteKey(r *request) (int, interface{}) {
+	for _, sn := range r.org.snapshots {
+		if sn.deleteKey == r.params["deleteKey"] {
+			return s.removeSnapshot(r.org, sn)
+		}
+	}
+	return http.StatusNotFound, message("Failed to get dashboard snapshot")
+}
+
+func (s *Server) removeSnapshot(o *org, sn *snapshot) (int, interface{}) {
+	delete(o.snapshots, sn.key)
+	return http.StatusOK, map[string]interface{}{
+		"id":      sn.id,
+		"message": "Snapshot deleted. It might take an hour before it's cleared from any CDN caches.",
+	}
+}
@@ -0,0 +1,204 @@
+package sdktest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bdunavant/sdk"
+)
+
+type team struct {
+	sdk.Team
+	members map[uint]bool
+	prefs   map[string]interface{}
+}
+
+func (t *team) public() sdk.Team {
+	out := t.Team
+	out.MemberCount = len(t.members)
+	return out
+}
+
+func (s *Server) teamParam(r *request) (*team, int, interface{}) {
+	t := r.org.teams[r.uintParam("id")]
+	if t == nil {
+		return nil, http.StatusNotFound, message("Team not found")
+	}
+	return t, 0, nil
+}
+
+func (o *org) teamByName(name string) *team {
+	for _, t := range o.teams {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *Server) searchTeams(r *request) (int, interface{}) {
+	var (
+		q       = r.URL.Query()
+		query   = strings.ToLower(q.Get("query"))
+		name    = q.Get("name")
+		perPage = 1000
+		page    = 1
+		found   = []sdk.Team{}
+	)
+	if v, err := strconv.Atoi(q.Get("perpage")); err == nil && v > 0 {
+		perPage = v
+	}
+	if v, err := strconv.Atoi(q.Get("page")); err == nil && v > 0 {
+		page = v
+	}
+	for _, t := range r.org.teams {
+		if (name == "" || t.Name == name) &&
+			(query == "" || strings.Contains(strings.ToLower(t.Name), query)) {
+			found = append(found, t.public())
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Name < found[j].Name })
+	result := sdk.PageTeams{TotalCount: len(found), Page: page, PerPage: perPage, Teams: []sdk.Team{}}
+	if start := (page - 1) * perPage; start < len(found) {
+		end := start + perPage
+		if end > len(found) {
+			end = len(found)
+		}
+		result.Teams = found[start:end]
+	}
+	return http.StatusOK, result
+}
+
+func (s *Server) getTeam(r *request) (int, interface{}) {
+	t, code, reply := s.teamParam(r)
+	if t == nil {
+		return code, reply
+	}
+	return http.StatusOK, t.public()
+}
+
+func (s *Server) createTeam(r *request) (int, interface{}) {
+	var in sdk.TeamRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if in.Name == "" {
+		return http.StatusBadRequest, message("Team name is required")
+	}
+	if r.org.teamByName(in.Name) != nil {
+		return http.StatusConflict, message("Team name taken")
+	}
+	id := s.nextID("team")
+	r.org.teams[id] = &team{
+		Team: sdk.Team{
+			ID:        id,
+			UID:       fmt.Sprintf("sdktest-team-%d", id),
+			OrgID:     r.org.ID,
+			Name:      in.Name,
+			Email:     in.Email,
+			AvatarURL: "/avatar/" + makeSlug(in.Name),
+		},
+		members: make(map[uint]bool),
+		prefs:   make(map[string]interface{}),
+	}
+	return http.StatusOK, map[string]interface{}{"teamId": id, "message": "Team created"}
+}
+
+func (s *Server) updateTeam(r *request) (int, interface{}) {
+	var in sdk.TeamRequest
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	t, code, reply := s.teamParam(r)
+	if t == nil {
+		return code, reply
+	}
+	if other := r.org.teamByName(in.Name); other != nil && other != t {
+		return http.StatusConflict, message("Team name taken")
+	}
+	t.Name = in.Name
+	t.Email = in.Email
+	return http.StatusOK, message("Team updated")
+}
+
+// deleteTeam deletes the team and the permissions granted to it as
+// Grafana does.
+func (s *Server) deleteTeam(r *request) (int, interface{}) {
+	t, code, reply := s.teamParam(r)
+	if t == nil {
+		return code, reply
+	}
+	delete(r.org.teams, t.ID)
+	for key, items := range r.org.permissions {
+		kept := make([]sdk.PermissionItem, 0, len(items))
+		for _, item := range items {
+			if item.TeamID != t.ID {
+				kept = append(kept, item)
+			}
+		}
+		r.org.permissions[key] = kept
+	}
+	return http.StatusOK, message("Team deleted")
+}
+
+func (s *Server) getTeamMembers(r *request) (int, interface{}) {
+	t, code, reply := s.teamParam(r)
+	if t == nil {
+		return code, reply
+	}
+	members := make([]sdk.TeamMember, 0, len(t.members))
+	for id := range t.members {
+		u := s.users[id]
+		if u == nil {
+			continue
+		}
+		members = append(members, sdk.TeamMember{
+			OrgID:  r.org.ID,
+			TeamID: t.ID,
+			UserID: id,
+			Email:  u.Email,
+			Name:   u.Name,
+			Login:  u.Login,
+			Labels: []string{},
+		})
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].UserID < members[j].UserID })
+	return http.StatusOK, members
+}
+
+func (s *Server) addTeamMember(r *request) (int, interface{}) {
+	var in struct {
+		UserID uint `json:"userId"`
+	}
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	t, code, reply := s.teamParam(r)
+	if t == nil {
+		return code, reply
+	}
+	if s.users[in.UserID] == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	if t.members[in.UserID] {
+		return http.StatusBadRequest, message("User is already added to this team")
+	}
+	t.members[in.UserID] = true
+	return http.StatusOK, message("Member added to Team")
+}
+
+func (s *Server) removeTeamMember(r *request) (int, interface{}) {
+	t, code, reply := s.teamParam(r)
+	if t == nil {
+		return code, reply
+	}
+	id := r.uintParam("userId")
+	if !t.members[id] {
+		return http.StatusNotFound, message("Team member not found")
+	}
+	delete(t.members, id)
+	return http.StatusOK, message("Team Member removed")
+}
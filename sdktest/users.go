@@ -0,0 +1,159 @@
+package sdktest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bdunavant/sdk"
+)
+
+type user struct {
+	sdk.User
+	password string
+	// sessions maps identifiers of auth tokens to them
+	sessions map[uint]*sdk.UserAuthToken
+}
+
+// public returns the user as Grafana shows it, without the password.
+func (u *user) public() sdk.User {
+	out := u.User
+	out.Password = ""
+	return out
+}
+
+func (s *Server) userByLoginOrEmail(loginOrEmail string) *user {
+	for _, u := range s.users {
+		if u.Login == loginOrEmail || u.Email == loginOrEmail {
+			return u
+		}
+	}
+	return nil
+}
+
+func (s *Server) sortedUsers() []sdk.User {
+	users := make([]sdk.User, 0, len(s.users))
+	for _, u := range s.users {
+		users = append(users, u.public())
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+func (s *Server) getActualUser(r *request) (int, interface{}) {
+	return http.StatusOK, r.user.public()
+}
+
+func (s *Server) getUser(r *request) (int, interface{}) {
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	return http.StatusOK, u.public()
+}
+
+func (s *Server) getAllUsers(r *request) (int, interface{}) {
+	return http.StatusOK, s.sortedUsers()
+}
+
+func (s *Server) searchUsers(r *request) (int, interface{}) {
+	var (
+		q       = r.URL.Query()
+		query   = strings.ToLower(q.Get("query"))
+		perPage = 1000
+		page    = 1
+		found   []sdk.User
+	)
+	if v, err := strconv.Atoi(q.Get("perpage")); err == nil && v > 0 {
+		perPage = v
+	}
+	if v, err := strconv.Atoi(q.Get("page")); err == nil && v > 0 {
+		page = v
+	}
+	for _, u := range s.sortedUsers() {
+		if query == "" ||
+			strings.Contains(strings.ToLower(u.Login), query) ||
+			strings.Contains(strings.ToLower(u.Email), query) ||
+			strings.Contains(strings.ToLower(u.Name), query) {
+			found = append(found, u)
+		}
+	}
+	result := sdk.PageUsers{TotalCount: len(found), Page: page, PerPage: perPage, Users: []sdk.User{}}
+	if start := (page - 1) * perPage; start < len(found) {
+		end := start + perPage
+		if end > len(found) {
+			end = len(found)
+		}
+		result.Users = found[start:end]
+	}
+	return http.StatusOK, result
+}
+
+func (s *Server) createUser(r *request) (int, interface{}) {
+	var in sdk.User
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	if in.Login == "" {
+		in.Login = in.Email
+	}
+	if s.userByLoginOrEmail(in.Login) != nil || (in.Email != "" && s.userByLoginOrEmail(in.Email) != nil) {
+		return http.StatusPreconditionFailed, message("User with same email or login already exists")
+	}
+	o := s.orgs[in.OrgID]
+	if o == nil {
+		o = s.orgs[s.admin.OrgID]
+	}
+	u := &user{
+		User: sdk.User{
+			ID:    s.nextID("user"),
+			Login: in.Login,
+			Name:  in.Name,
+			Email: in.Email,
+			Theme: in.Theme,
+			OrgID: o.ID,
+		},
+		password: in.Password,
+	}
+	s.users[u.ID] = u
+	o.users[u.ID] = "Viewer"
+	return http.StatusOK, map[string]interface{}{"id": u.ID, "message": "User created"}
+}
+
+func (s *Server) updateUserPermissions(r *request) (int, interface{}) {
+	var in sdk.UserPermissions
+	if err := r.decode(&in); err != nil {
+		return badRequest(err)
+	}
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	u.IsGrafanaAdmin = in.IsGrafanaAdmin
+	return http.StatusOK, message("User permissions updated")
+}
+
+func (s *Server) switchActualUserContext(r *request) (int, interface{}) {
+	return s.switchOrg(r.user, r.uintParam("orgId"))
+}
+
+func (s *Server) switchUserContext(r *request) (int, interface{}) {
+	u := s.users[r.uintParam("userId")]
+	if u == nil {
+		return http.StatusNotFound, message("User not found")
+	}
+	return s.switchOrg(u, r.uintParam("orgId"))
+}
+
+func (s *Server) switchOrg(u *user, orgID uint) (int, interface{}) {
+	o := s.orgs[orgID]
+	if o == nil {
+		return http.StatusNotFound, message("Organization not found")
+	}
+	if _, ok := o.users[u.ID]; !ok {
+		return http.StatusUnauthorized, message("Not a valid organization")
+	}
+	u.OrgID = orgID
+	return http.StatusOK, message("Active organization changed")
+}
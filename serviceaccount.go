@@ -0,0 +1,80 @@
+package sdk
+
+import "time"
+
+// ServiceAccount is representation of a Grafana service account.
+type ServiceAccount struct {
+	ID            uint            `json:"id"`
+	UID           string          `json:"uid,omitempty"`
+	OrgID         uint            `json:"orgId"`
+	Name          string          `json:"name"`
+	Login         string          `json:"login"`
+	Role          string          `json:"role"`
+	IsDisabled    bool            `json:"isDisabled"`
+	Tokens        int64           `json:"tokens"`
+	AvatarURL     string          `json:"avatarUrl,omitempty"`
+	AccessControl map[string]bool `json:"accessControl,omitempty"`
+}
+
+// PageServiceAccounts is a page of the service accounts search result.
+type PageServiceAccounts struct {
+	TotalCount      int              `json:"totalCount"`
+	ServiceAccounts []ServiceAccount `json:"serviceAccounts"`
+	Page            int              `json:"page"`
+	PerPage         int              `json:"perPage"`
+}
+
+// CreateServiceAccountRequest is a request to create a new service account.
+type CreateServiceAccountRequest struct {
+	Name       string `json:"name"`
+	Role       string `json:"role,omitempty"`
+	IsDisabled bool   `json:"isDisabled,omitempty"`
+}
+
+// UpdateServiceAccountRequest is a request to update the service account.
+// Only the set fields are changed.
+type UpdateServiceAccountRequest struct {
+	Name       *string `json:"name,omitempty"`
+	Role       *string `json:"role,omitempty"`
+	IsDisabled *bool   `json:"isDisabled,omitempty"`
+}
+
+// ServiceAccountToken is representation of a token of the service
+// account. The token key is only returned once on creation, see
+// NewServiceAccountToken.
+type ServiceAccountToken struct {
+	ID                     uint       `json:"id"`
+	Name                   string     `json:"name"`
+	Created                *time.Time `json:"created,omitempty"`
+	LastUsedAt             *time.Time `json:"lastUsedAt,omitempty"`
+	Expiration             *time.Time `json:"expiration,omitempty"`
+	SecondsUntilExpiration *float64   `json:"secondsUntilExpiration,omitempty"`
+	HasExpired             bool       `json:"hasExpired"`
+	IsRevoked              bool       `json:"isRevoked,omitempty"`
+}
+
+// CreateServiceAccountTokenRequest is a request to create a new token
+// of the service account. Zero SecondsToLive means the token never
+// expires.
+type CreateServiceAccountTokenRequest struct {
+	Name          string `json:"name"`
+	SecondsToLive int64  `json:"secondsToLive,omitempty"`
+}
+
+// NewServiceAccountToken is the created token with its key. The key
+// could be used as API key of the client.
+type NewServiceAccountToken struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// ServiceAccountMigrationResult is the result of API keys migration
+// to service accounts.
+type ServiceAccountMigrationResult struct {
+	Total           int      `json:"total"`
+	Migrated        int      `json:"migrated"`
+	Failed          int      `json:"failed"`
+	FailedAPIKeyIDs []uint   `json:"failedApikeyIDs,omitempty"`
+	FailedDetails   []string `json:"failedDetails,omitempty"`
+}
@@ -1,7 +1,39 @@
 package sdk
 
+import "time"
+
 // CreateSnapshotRequest is representation of a snapshot request.
+// Grafana generates the keys unless they are set. Expires is the lifetime
+// of the snapshot in seconds, zero means it never expires.
 type CreateSnapshotRequest struct {
-	Expires   uint  `json:"expires"`
-	Dashboard Board `json:"dashboard"`
+	Expires   uint   `json:"expires"`
+	Dashboard Board  `json:"dashboard"`
+	Name      string `json:"name,omitempty"`
+	External  bool   `json:"external,omitempty"`
+	Key       string `json:"key,omitempty"`
+	DeleteKey string `json:"deleteKey,omitempty"`
+}
+
+// SnapshotResponse is the result of the snapshot creation. DeleteKey
+// allows to delete the snapshot without authorization.
+type SnapshotResponse struct {
+	ID        uint   `json:"id"`
+	Key       string `json:"key"`
+	DeleteKey string `json:"deleteKey"`
+	URL       string `json:"url"`
+	DeleteURL string `json:"deleteUrl"`
+}
+
+// Snapshot is the snapshot in the list of snapshots.
+type Snapshot struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Key         string    `json:"key"`
+	OrgID       uint      `json:"orgId"`
+	UserID      uint      `json:"userId"`
+	External    bool      `json:"external"`
+	ExternalURL string    `json:"externalUrl"`
+	Expires     time.Time `json:"expires"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
 }
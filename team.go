@@ -0,0 +1,48 @@
+package sdk
+
+// Team is representation of a Grafana team. Teams could be granted
+// permissions to dashboards and folders, see TeamPermission.
+type Team struct {
+	ID          uint           `json:"id"`
+	UID         string         `json:"uid,omitempty"`
+	OrgID       uint           `json:"orgId"`
+	Name        string         `json:"name"`
+	Email       string         `json:"email"`
+	AvatarURL   string         `json:"avatarUrl,omitempty"`
+	MemberCount int            `json:"memberCount"`
+	Permission  PermissionType `json:"permission"`
+}
+
+// Grant returns the grant of the permission for the team to use in
+// dashboard and folder ACLs.
+func (t Team) Grant(p PermissionType) PermissionItem {
+	return TeamPermission(t.ID, p)
+}
+
+// PageTeams is a page of the teams search result.
+type PageTeams struct {
+	TotalCount int    `json:"totalCount"`
+	Teams      []Team `json:"teams"`
+	Page       int    `json:"page"`
+	PerPage    int    `json:"perPage"`
+}
+
+// TeamRequest is a request to create or update the team. The email is
+// optional.
+type TeamRequest struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+// TeamMember is the user in the team.
+type TeamMember struct {
+	OrgID      uint           `json:"orgId"`
+	TeamID     uint           `json:"teamId"`
+	UserID     uint           `json:"userId"`
+	Email      string         `json:"email"`
+	Name       string         `json:"name"`
+	Login      string         `json:"login"`
+	AvatarURL  string         `json:"avatarUrl,omitempty"`
+	Labels     []string       `json:"labels,omitempty"`
+	Permission PermissionType `json:"permission"`
+}
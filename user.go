@@ -28,6 +28,7 @@ type User struct {
 	OrgID          uint   `json:"orgId"`
 	Password       string `json:"password"`
 	IsGrafanaAdmin bool   `json:"isGrafanaAdmin"`
+	IsDisabled     bool   `json:"isDisabled,omitempty"`
 }
 
 type UserRole struct {
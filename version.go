@@ -0,0 +1,273 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupported is returned by the methods which API is not available
+// in the version of Grafana server the client talks to.
+var ErrUnsupported = errors.New("not supported by the Grafana version")
+
+// Version is the semantic version of Grafana server.
+type Version struct {
+	Major, Minor, Patch int
+	// Pre is the pre-release part such as "beta1", it is empty for
+	// releases. Build metadata after "+" is dropped.
+	Pre string
+}
+
+// ParseVersion parses the version as it reported by Grafana,
+// for example "7.5.0", "v9.1.0-beta1" or "10.0.3+security-01".
+// The minor and patch parts are optional.
+func ParseVersion(s string) (Version, error) {
+	var v Version
+	in := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexByte(in, '+'); i >= 0 {
+		in = in[:i]
+	}
+	if i := strings.IndexByte(in, '-'); i >= 0 {
+		in, v.Pre = in[:i], in[i+1:]
+	}
+	parts := strings.Split(in, ".")
+	if len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q", s)
+	}
+	for i, dst := range []*int{&v.Major, &v.Minor, &v.Patch} {
+		if i >= len(parts) {
+			break
+		}
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", s)
+		}
+		*dst = n
+	}
+	return v, nil
+}
+
+// String returns the version in form major.minor.patch[-pre].
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Pre != "" {
+		s += "-" + v.Pre
+	}
+	return s
+}
+
+// Compare returns -1, 0 or 1 when the version is lower, equal or higher
+// than the other. A pre-release is lower than its release.
+func (v Version) Compare(other Version) int {
+	for _, d := range []int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch} {
+		switch {
+		case d < 0:
+			return -1
+		case d > 0:
+			return 1
+		}
+	}
+	switch {
+	case v.Pre == other.Pre:
+		return 0
+	case v.Pre == "":
+		return 1
+	case other.Pre == "":
+		return -1
+	case v.Pre < other.Pre:
+		return -1
+	}
+	return 1
+}
+
+// AtLeast reports whether the version is equal or higher than
+// major.minor.patch. Pre-releases are counted as the release, so
+// 9.1.0-beta1 is at least 9.1.0.
+func (v Version) AtLeast(major, minor, patch int) bool {
+	release := v
+	release.Pre = ""
+	return release.Compare(Version{Major: major, Minor: minor, Patch: patch}) >= 0
+}
+
+// Capability is an API feature that exists only in some versions of
+// Grafana.
+type Capability string
+
+// Capabilities known by the SDK.
+const (
+	// CapDashboardSlugAPI is /api/dashboards/db/:slug endpoints,
+	// removed in Grafana 8.0 in favor of the uid endpoints.
+	CapDashboardSlugAPI Capability = "dashboard slug API"
+	// CapLegacyAlerting is /api/alert-notifications endpoints,
+	// removed in Grafana 11.0 in favor of unified alerting.
+	CapLegacyAlerting Capability = "legacy alerting"
+	// CapUnifiedAlerting is the alerting of Grafana 8.0 and later.
+	CapUnifiedAlerting Capability = "unified alerting"
+	// CapDatasourceRefs is referencing datasources in panels and
+	// targets as objects with uid and type since Grafana 8.3.
+	CapDatasourceRefs Capability = "datasource refs as objects"
+	// CapLibraryPanels is /api/library-elements endpoints.
+	CapLibraryPanels Capability = "library panels"
+	// CapServiceAccounts is /api/serviceaccounts endpoints.
+	CapServiceAccounts Capability = "service accounts"
+	// CapPublicDashboards is the public dashboards API.
+	CapPublicDashboards Capability = "public dashboards"
+	// CapNestedFolders is folders inside other folders.
+	CapNestedFolders Capability = "nested folders"
+)
+
+// versionRange is [since, until) range of versions, zero until means
+// the capability is not removed.
+type versionRange struct {
+	since, until Version
+}
+
+var capabilities = map[Capability]versionRange{
+	CapDashboardSlugAPI: {until: Version{Major: 8}},
+	CapLegacyAlerting:   {until: Version{Major: 11}},
+	CapUnifiedAlerting:  {since: Version{Major: 8}},
+	CapDatasourceRefs:   {since: Version{Major: 8, Minor: 3}},
+	CapLibraryPanels:    {since: Version{Major: 8}},
+	CapServiceAccounts:  {since: Version{Major: 9}},
+	CapPublicDashboards: {since: Version{Major: 10}},
+	CapNestedFolders:    {since: Version{Major: 11}},
+}
+
+// Supports reports whether Grafana of the version has the capability.
+// Unknown capabilities are not supported.
+func (v Version) Supports(c Capability) bool {
+	rng, ok := capabilities[c]
+	if !ok {
+		return false
+	}
+	if !v.AtLeast(rng.since.Major, rng.since.Minor, rng.since.Patch) {
+		return false
+	}
+	return rng.until == (Version{}) || !v.AtLeast(rng.until.Major, rng.until.Minor, rng.until.Patch)
+}
+
+// serverVersion caches the detected version or the reason it is
+// unknown. It is shared by the copies of the client made with WithOrg
+// because they talk to the same server.
+type serverVersion struct {
+	mu      sync.Mutex
+	version *Version
+	err     error
+	// detecting is closed when the running detection finishes
+	detecting chan struct{}
+}
+
+// WithServerVersion sets the version of Grafana server so the client
+// doesn't detect it with the request to /api/health.
+func WithServerVersion(v Version) ClientOption {
+	return func(c *Client) {
+		c.version = &serverVersion{version: &v}
+	}
+}
+
+// ServerVersion returns the version of Grafana server. The version is
+// requested from /api/health on the first call and cached by the client.
+// Definitive failures are cached too, so the version stays unknown for
+// the client lifetime when Grafana hides it, sends an invalid one or
+// denies access to /api/health. Other errors, like network ones or 5xx
+// replies of restarting Grafana, are not cached and the next call tries
+// again. Concurrent calls wait for the single detection or until their
+// contexts are done.
+func (r *Client) ServerVersion(ctx context.Context) (Version, error) {
+	sv := r.version
+	for {
+		sv.mu.Lock()
+		if sv.version != nil || sv.err != nil {
+			v, err := sv.version, sv.err
+			sv.mu.Unlock()
+			if err != nil {
+				return Version{}, err
+			}
+			return *v, nil
+		}
+		wait := sv.detecting
+		if wait == nil {
+			break
+		}
+		sv.mu.Unlock()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return Version{}, ctx.Err()
+		}
+	}
+	done := make(chan struct{})
+	sv.detecting = done
+	sv.mu.Unlock()
+
+	v, definitive, err := r.detectVersion(ctx)
+
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	switch {
+	case err == nil:
+		sv.version = &v
+	case definitive:
+		sv.err = err
+	}
+	sv.detecting = nil
+	close(done)
+	return v, err
+}
+
+// detectVersion requests the version from /api/health. It reports
+// whether the error is the definitive answer of Grafana that doesn't
+// change on repeating the request.
+func (r *Client) detectVersion(ctx context.Context) (Version, bool, error) {
+	var (
+		raw    []byte
+		code   int
+		health HealthResponse
+		v      Version
+		err    error
+	)
+	if raw, code, err = r.get(ctx, "/api/health", nil); err != nil {
+		switch code {
+		case http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound:
+			return Version{}, true, err
+		}
+		return Version{}, false, err
+	}
+	if err = json.Unmarshal(raw, &health); err == nil {
+		v, err = ParseVersion(health.Version)
+	}
+	if err != nil {
+		return Version{}, true, fmt.Errorf("detect version: %w", err)
+	}
+	return v, false, nil
+}
+
+// Supports reports whether Grafana server has the capability.
+func (r *Client) Supports(ctx context.Context, c Capability) (bool, error) {
+	v, err := r.ServerVersion(ctx)
+	if err != nil {
+		return false, err
+	}
+	return v.Supports(c), nil
+}
+
+// require returns error wrapping ErrUnsupported if the server has no
+// capability. When the version can't be detected, for example because
+// Grafana hides it from anonymous users, the call is allowed and
+// the server decides. The context error is returned if the context is
+// done.
+func (r *Client) require(ctx context.Context, c Capability) error {
+	v, err := r.ServerVersion(ctx)
+	if err != nil {
+		return ctx.Err()
+	}
+	if !v.Supports(c) {
+		return fmt.Errorf("%s in Grafana %s: %w", c, v, ErrUnsupported)
+	}
+	return nil
+}
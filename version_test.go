@@ -0,0 +1,201 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bdunavant/sdk"
+	"github.com/bdunavant/sdk/sdktest"
+)
+
+func TestParseVersion(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want sdk.Version
+	}{
+		{"7.5.0", sdk.Version{Major: 7, Minor: 5}},
+		{"v9.1.0-beta1", sdk.Version{Major: 9, Minor: 1, Pre: "beta1"}},
+		{"10.0.3+security-01", sdk.Version{Major: 10, Patch: 3}},
+		{"11", sdk.Version{Major: 11}},
+	} {
+		got, err := sdk.ParseVersion(tc.in)
+		if err != nil {
+			t.Errorf("%s: %s", tc.in, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s: expected %v, got %v", tc.in, tc.want, got)
+		}
+	}
+	for _, in := range []string{"", "x.1", "1.2.3.4"} {
+		if _, err := sdk.ParseVersion(in); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestVersion_Supports(t *testing.T) {
+	beta := sdk.Version{Major: 9, Pre: "beta1"}
+	if beta.Compare(sdk.Version{Major: 9}) != -1 || !beta.AtLeast(9, 0, 0) {
+		t.Error("expected pre-release lower than the release but counted as it")
+	}
+	for _, tc := range []struct {
+		version  sdk.Version
+		cap      sdk.Capability
+		expected bool
+	}{
+		{sdk.Version{Major: 7, Minor: 5}, sdk.CapDashboardSlugAPI, true},
+		{sdk.Version{Major: 8}, sdk.CapDashboardSlugAPI, false},
+		{sdk.Version{Major: 8, Minor: 2}, sdk.CapDatasourceRefs, false},
+		{sdk.Version{Major: 8, Minor: 3}, sdk.CapDatasourceRefs, true},
+		{sdk.Version{Major: 10, Minor: 4}, sdk.CapLegacyAlerting, true},
+		{sdk.Version{Major: 11}, sdk.CapLegacyAlerting, false},
+		{sdk.Version{Major: 11}, sdk.Capability("unknown"), false},
+	} {
+		if got := tc.version.Supports(tc.cap); got != tc.expected {
+			t.Errorf("%s in %s: expected %v, got %v", tc.cap, tc.version, tc.expected, got)
+		}
+	}
+}
+
+func TestClient_ServerVersion(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/health" {
+			t.Fatalf("unexpected request %s", r.URL.Path)
+		}
+		calls++
+		w.Write([]byte(`{"commit":"abc","database":"ok","version":"8.4.1"}`))
+	}))
+	defer ts.Close()
+	ctx := context.Background()
+
+	client := sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()))
+	v, err := client.ServerVersion(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "8.4.1" {
+		t.Errorf("unexpected version %s", v)
+	}
+	if ok, err := client.WithOrg(2).Supports(ctx, sdk.CapUnifiedAlerting); err != nil || !ok {
+		t.Errorf("expected unified alerting supported, got %v, %v", ok, err)
+	}
+	if _, _, err = client.GetDashboardBySlug(ctx, "db/home"); !errors.Is(err, sdk.ErrUnsupported) {
+		t.Errorf("expected unsupported error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected the version cached after the first request, got %d requests", calls)
+	}
+}
+
+func TestClient_WithServerVersion(t *testing.T) {
+	srv := sdktest.NewServer(t)
+	defer srv.Close()
+	client := sdk.NewClientWithOptions(srv.URL,
+		sdk.WithBasicAuth(sdktest.AdminLogin, sdktest.AdminPassword),
+		sdk.WithServerVersion(sdk.Version{Major: 8, Minor: 5}))
+	if _, err := client.GetServiceAccount(context.Background(), 1); !errors.Is(err, sdk.ErrUnsupported) {
+		t.Errorf("expected unsupported error, got %v", err)
+	}
+}
+
+func TestClient_ServerVersionUnknown(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/health" {
+			w.Write([]byte(`{"id":1}`))
+			return
+		}
+		calls++
+		w.Write([]byte(`{"commit":"abc","database":"ok"}`))
+	}))
+	defer ts.Close()
+	ctx := context.Background()
+
+	client := sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()))
+	if _, err := client.ServerVersion(ctx); err == nil {
+		t.Fatal("expected error for the hidden version")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetServiceAccount(ctx, 1); err != nil {
+			t.Errorf("expected the call allowed for the unknown version, got %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected the unknown version cached after the first request, got %d requests", calls)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	client = sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()))
+	if _, err := client.GetServiceAccount(cancelled, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context error, got %v", err)
+	}
+	if _, err := client.ServerVersion(ctx); err == nil || calls != 2 {
+		t.Errorf("expected the version detected again after the cancelled request, got %d requests: %v", calls, err)
+	}
+}
+
+func TestClient_ServerVersionUnavailable(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		calls   int
+		release = make(chan struct{})
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		n := calls
+		mu.Unlock()
+		switch n {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			<-release
+			fallthrough
+		default:
+			w.Write([]byte(`{"version":"10.4.0"}`))
+		}
+	}))
+	defer ts.Close()
+	ctx := context.Background()
+
+	client := sdk.NewClientWithOptions(ts.URL, sdk.WithHTTPClient(ts.Client()))
+	var apiErr *sdk.APIError
+	if _, err := client.ServerVersion(ctx); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected unavailable error, got %v", err)
+	}
+
+	detected := make(chan error, 1)
+	go func() {
+		_, err := client.ServerVersion(ctx)
+		detected <- err
+	}()
+	for {
+		mu.Lock()
+		n := calls
+		mu.Unlock()
+		if n == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	waiting, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.WithOrg(2).ServerVersion(waiting); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the wait for the running detection to stop with the context, got %v", err)
+	}
+	close(release)
+	if err := <-detected; err != nil {
+		t.Fatal(err)
+	}
+	if v, err := client.ServerVersion(ctx); err != nil || v.String() != "10.4.0" || calls != 2 {
+		t.Errorf("expected the version detected after the unavailable reply, got %s in %d requests: %v", v, calls, err)
+	}
+}